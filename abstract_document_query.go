@@ -1,6 +1,7 @@
 package ravendb
 
 import (
+	"context"
 	"reflect"
 	"strconv"
 	"strings"
@@ -48,6 +49,20 @@ type abstractDocumentQuery struct {
 
 	includes []string
 
+	// names of query parameters holding included counter names
+	counterIncludes    []string
+	includeAllCounters bool
+
+	timeSeriesIncludes []*TimeSeriesRange
+
+	highlightingTokens []*highlightingToken
+	queryHighlightings *queryHighlightings
+
+	explanationToken *explanationToken
+	explanations     *Explanations
+
+	queryTimings *QueryTimings
+
 	queryStats *QueryStatistics
 
 	disableEntitiesTracking bool
@@ -65,6 +80,8 @@ type abstractDocumentQuery struct {
 	afterStreamExecutedCallback []func(map[string]interface{})
 
 	queryOperation *queryOperation
+	// type of results requested by GetResults(), First() or Single()
+	resultType reflect.Type
 
 	// to allow "fluid" API, in many methods instead of returning an error, we
 	// remember it here and return in GetResults()
@@ -108,6 +125,7 @@ func newAbstractDocumentQuery(opts *DocumentQueryOptions) *abstractDocumentQuery
 		aliasToGroupByFieldName: make(map[string]string),
 		queryParameters:         make(map[string]interface{}),
 		queryStats:              NewQueryStatistics(),
+		queryHighlightings:      &queryHighlightings{},
 		queryRaw:                opts.rawQuery,
 	}
 
@@ -159,7 +177,15 @@ func (q *abstractDocumentQuery) initializeQueryOperation() (*queryOperation, err
 		return nil, err
 	}
 
-	return newQueryOperation(q.theSession, q.indexName, indexQuery, q.fieldsToFetchToken, q.disableEntitiesTracking, false, false)
+	op, err := newQueryOperation(q.theSession, q.indexName, indexQuery, q.fieldsToFetchToken, q.disableEntitiesTracking, false, false)
+	if err != nil {
+		return nil, err
+	}
+	// callbacks get QueryResult with Results, which typed results don't set
+	if !q.hasAfterQueryExecutedCallbacks() {
+		op.resultType = q.resultType
+	}
+	return op, nil
 }
 
 func (q *abstractDocumentQuery) GetIndexQuery() (*IndexQuery, error) {
@@ -367,6 +393,90 @@ func (q *abstractDocumentQuery) include(path string) {
 	q.includes = append(q.includes, path)
 }
 
+func (q *abstractDocumentQuery) includeCounters(counters []string) error {
+	if q.includeAllCounters {
+		return newIllegalStateError("IncludeAllCounters() and IncludeCounters() cannot be used together")
+	}
+	for _, counter := range counters {
+		if stringIsBlank(counter) {
+			return newIllegalArgumentError("counter cannot be empty")
+		}
+		q.counterIncludes = append(q.counterIncludes, q.addQueryParameter(counter))
+	}
+	return nil
+}
+
+func (q *abstractDocumentQuery) includeAllCountersInQuery() error {
+	if len(q.counterIncludes) > 0 {
+		return newIllegalStateError("IncludeAllCounters() and IncludeCounters() cannot be used together")
+	}
+	q.includeAllCounters = true
+	return nil
+}
+
+func (q *abstractDocumentQuery) includeTimeSeries(name string, from *time.Time, to *time.Time) error {
+	if stringIsBlank(name) {
+		return newIllegalArgumentError("name cannot be empty")
+	}
+	q.timeSeriesIncludes = append(q.timeSeriesIncludes, &TimeSeriesRange{
+		Name: name,
+		From: from,
+		To:   to,
+	})
+	return nil
+}
+
+func (q *abstractDocumentQuery) highlight(fieldName string, fragmentLength int, fragmentCount int, options *HighlightingOptions, highlightings **Highlightings) error {
+	if stringIsBlank(fieldName) {
+		return newIllegalArgumentError("fieldName cannot be empty")
+	}
+	if highlightings == nil {
+		return newIllegalArgumentError("highlightings cannot be nil")
+	}
+	*highlightings = q.queryHighlightings.add(fieldName)
+	optionsParameterName := ""
+	if options != nil {
+		optionsParameterName = q.addQueryParameter(options)
+	}
+	q.highlightingTokens = append(q.highlightingTokens, &highlightingToken{
+		fieldName:            fieldName,
+		fragmentLength:       fragmentLength,
+		fragmentCount:        fragmentCount,
+		optionsParameterName: optionsParameterName,
+	})
+	return nil
+}
+
+func (q *abstractDocumentQuery) includeExplanations(options *ExplanationOptions, explanations **Explanations) error {
+	if explanations == nil {
+		return newIllegalArgumentError("explanations cannot be nil")
+	}
+	if q.explanationToken != nil {
+		return newIllegalStateError("Duplicate ExplainScores() calls are forbidden")
+	}
+	optionsParameterName := ""
+	if options != nil {
+		optionsParameterName = q.addQueryParameter(options)
+	}
+	q.explanationToken = &explanationToken{
+		optionsParameterName: optionsParameterName,
+	}
+	q.explanations = &Explanations{}
+	*explanations = q.explanations
+	return nil
+}
+
+func (q *abstractDocumentQuery) includeTimings(timings **QueryTimings) error {
+	if timings == nil {
+		return newIllegalArgumentError("timings cannot be nil")
+	}
+	if q.queryTimings == nil {
+		q.queryTimings = &QueryTimings{}
+	}
+	*timings = q.queryTimings
+	return nil
+}
+
 func (q *abstractDocumentQuery) take(count int) {
 	q.pageSize = &count
 }
@@ -1081,6 +1191,34 @@ func (q *abstractDocumentQuery) orderByDescendingWithOrdering(field string, orde
 	return nil
 }
 
+func (q *abstractDocumentQuery) orderByWithSorter(field string, sorterName string, descending bool) error {
+	if err := q.assertNoRawQuery(); err != nil {
+		return err
+	}
+	if stringIsBlank(sorterName) {
+		return newIllegalArgumentError("sorterName cannot be empty")
+	}
+	f, err := q.ensureValidFieldName(field, false)
+	if err != nil {
+		return err
+	}
+	q.orderByTokens = append(q.orderByTokens, orderByTokenCreateWithSorter(f, sorterName, descending))
+	return nil
+}
+
+// customSortUsing orders by document id with a custom sorter, the sorter
+// decides the order of documents
+func (q *abstractDocumentQuery) customSortUsing(sorterName string, descending bool) error {
+	if err := q.assertNoRawQuery(); err != nil {
+		return err
+	}
+	if stringIsBlank(sorterName) {
+		return newIllegalArgumentError("sorterName cannot be empty")
+	}
+	q.orderByTokens = append(q.orderByTokens, orderByTokenCreateWithSorter(IndexingFieldNameDocumentID, sorterName, descending))
+	return nil
+}
+
 func (q *abstractDocumentQuery) orderByScore() error {
 	if err := q.assertNoRawQuery(); err != nil {
 		return err
@@ -1102,6 +1240,17 @@ func (q *abstractDocumentQuery) statistics(stats **QueryStatistics) {
 	*stats = q.queryStats
 }
 
+// hasAfterQueryExecutedCallbacks returns true if there are callbacks added
+// by the user. The first callback is always ours and updates statistics
+func (q *abstractDocumentQuery) hasAfterQueryExecutedCallbacks() bool {
+	for i, cb := range q.afterQueryExecutedCallback {
+		if i > 0 && cb != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func (q *abstractDocumentQuery) invokeAfterQueryExecuted(result *QueryResult) {
 	for _, cb := range q.afterQueryExecutedCallback {
 		if cb != nil {
@@ -1218,16 +1367,18 @@ func (q *abstractDocumentQuery) string() (string, error) {
 }
 
 func (q *abstractDocumentQuery) buildInclude(queryText *strings.Builder) error {
-	if len(q.includes) == 0 {
+	if len(q.includes) == 0 && len(q.counterIncludes) == 0 && !q.includeAllCounters && len(q.timeSeriesIncludes) == 0 && len(q.highlightingTokens) == 0 && q.explanationToken == nil && q.queryTimings == nil {
 		return nil
 	}
 
 	q.includes = stringArrayRemoveDuplicates(q.includes)
 	queryText.WriteString(" include ")
-	for i, include := range q.includes {
-		if i > 0 {
+	first := true
+	for _, include := range q.includes {
+		if !first {
 			queryText.WriteString(",")
 		}
+		first = false
 
 		requiredQuotes := false
 
@@ -1247,9 +1398,83 @@ func (q *abstractDocumentQuery) buildInclude(queryText *strings.Builder) error {
 			queryText.WriteString(include)
 		}
 	}
+
+	if q.includeAllCounters {
+		if !first {
+			queryText.WriteString(",")
+		}
+		first = false
+		queryText.WriteString("counters()")
+	}
+	for _, parameterName := range q.counterIncludes {
+		if !first {
+			queryText.WriteString(",")
+		}
+		first = false
+		queryText.WriteString("counters($")
+		queryText.WriteString(parameterName)
+		queryText.WriteString(")")
+	}
+
+	for _, r := range q.timeSeriesIncludes {
+		if !first {
+			queryText.WriteString(",")
+		}
+		first = false
+		writeTimeSeriesInclude(queryText, r)
+	}
+
+	for _, token := range q.highlightingTokens {
+		if !first {
+			queryText.WriteString(",")
+		}
+		first = false
+		if err := token.writeTo(queryText); err != nil {
+			return err
+		}
+	}
+
+	if q.explanationToken != nil {
+		if !first {
+			queryText.WriteString(",")
+		}
+		first = false
+		if err := q.explanationToken.writeTo(queryText); err != nil {
+			return err
+		}
+	}
+
+	if q.queryTimings != nil {
+		if !first {
+			queryText.WriteString(",")
+		}
+		if err := timingsTokenInstance.writeTo(queryText); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// writes timeseries('name', 'from', 'to') where unbounded from or to are null
+func writeTimeSeriesInclude(queryText *strings.Builder, r *TimeSeriesRange) {
+	queryText.WriteString("timeseries('")
+	queryText.WriteString(strings.Replace(r.Name, "'", "\\'", -1))
+	queryText.WriteString("', ")
+	for i, t := range []*time.Time{r.From, r.To} {
+		if i > 0 {
+			queryText.WriteString(", ")
+		}
+		if t == nil {
+			queryText.WriteString("null")
+			continue
+		}
+		queryText.WriteString("'")
+		queryText.WriteString(formatTimeSeriesTime(*t))
+		queryText.WriteString("'")
+	}
+	queryText.WriteString(")")
+}
+
 func (q *abstractDocumentQuery) intersect() error {
 
 	tokensRef, err := q.getCurrentWhereTokensRef()
@@ -1377,7 +1602,13 @@ func (q *abstractDocumentQuery) distinct() error {
 
 func (q *abstractDocumentQuery) updateStatsAndHighlightings(queryResult *QueryResult) {
 	q.queryStats.UpdateQueryStats(queryResult)
-	//TBD 4.1 Highlightings.Update(queryResult);
+	q.queryHighlightings.update(queryResult)
+	if q.explanations != nil {
+		q.explanations.update(queryResult)
+	}
+	if q.queryTimings != nil {
+		q.queryTimings.update(queryResult)
+	}
 }
 
 func (q *abstractDocumentQuery) buildSelect(writer *strings.Builder) error {
@@ -2052,7 +2283,7 @@ func (q *abstractDocumentQuery) assertIsDynamicQuery(dynamicField DynamicSpatial
 	return nil
 }
 
-func (q *abstractDocumentQuery) initSync() error {
+func (q *abstractDocumentQuery) initSync(ctx context.Context) error {
 	if q.queryOperation != nil {
 		return nil
 	}
@@ -2071,10 +2302,10 @@ func (q *abstractDocumentQuery) initSync() error {
 	if err != nil {
 		return err
 	}
-	return q.executeActualQuery()
+	return q.executeActualQuery(ctx)
 }
 
-func (q *abstractDocumentQuery) executeActualQuery() error {
+func (q *abstractDocumentQuery) executeActualQuery(ctx context.Context) error {
 	{
 		context := q.queryOperation.enterQueryContext()
 		defer func() {
@@ -2085,7 +2316,7 @@ func (q *abstractDocumentQuery) executeActualQuery() error {
 		if err != nil {
 			return err
 		}
-		if err = q.theSession.GetRequestExecutor().ExecuteCommand(command, q.theSession.sessionInfo); err != nil {
+		if err = q.theSession.GetRequestExecutor().ExecuteCommandCtx(ctx, command, q.theSession.sessionInfo); err != nil {
 			return err
 		}
 		if err = q.queryOperation.setResult(command.Result); err != nil {
@@ -2097,8 +2328,8 @@ func (q *abstractDocumentQuery) executeActualQuery() error {
 }
 
 // GetQueryResult returns results of a query
-func (q *abstractDocumentQuery) getQueryResult() (*QueryResult, error) {
-	err := q.initSync()
+func (q *abstractDocumentQuery) getQueryResult(ctx context.Context) (*QueryResult, error) {
+	err := q.initSync(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2115,6 +2346,12 @@ func checkValidGetResultsArg(v interface{}, argName string) error {
 // GetResults executes the query and sets results to returned values.
 // results should be of type *[]<type>
 func (q *abstractDocumentQuery) GetResults(results interface{}) error {
+	return q.GetResultsCtx(context.Background(), results)
+}
+
+// GetResultsCtx is like GetResults but the request to the server is cancelled
+// when ctx is done
+func (q *abstractDocumentQuery) GetResultsCtx(ctx context.Context, results interface{}) error {
 	// Note: in Java it's called ToList
 	if q.err != nil {
 		return q.err
@@ -2122,7 +2359,7 @@ func (q *abstractDocumentQuery) GetResults(results interface{}) error {
 	if q.err = checkValidGetResultsArg(results, "results"); q.err != nil {
 		return q.err
 	}
-	return q.executeQueryOperation(results, -1)
+	return q.executeQueryOperation(ctx, results, -1)
 }
 
 func checkValidSingleArg(v interface{}, argName string) error {
@@ -2134,6 +2371,12 @@ func checkValidSingleArg(v interface{}, argName string) error {
 
 // First runs a query and returns a first result.
 func (q *abstractDocumentQuery) First(result interface{}) error {
+	return q.FirstCtx(context.Background(), result)
+}
+
+// FirstCtx is like First but the request to the server is cancelled
+// when ctx is done
+func (q *abstractDocumentQuery) FirstCtx(ctx context.Context, result interface{}) error {
 	if q.err != nil {
 		return q.err
 	}
@@ -2150,7 +2393,7 @@ func (q *abstractDocumentQuery) First(result interface{}) error {
 	// create a pointer to a slice. executeQueryOperation creates the actual slice
 	sliceType := reflect.SliceOf(tp)
 	slicePtr := reflect.New(sliceType)
-	err := q.executeQueryOperation(slicePtr.Interface(), 1)
+	err := q.executeQueryOperation(ctx, slicePtr.Interface(), 1)
 	if err != nil {
 		return err
 	}
@@ -2165,6 +2408,12 @@ func (q *abstractDocumentQuery) First(result interface{}) error {
 // Single runs a query that expects only a single result.
 // If there is more than one result, it returns IllegalStateError.
 func (q *abstractDocumentQuery) Single(result interface{}) error {
+	return q.SingleCtx(context.Background(), result)
+}
+
+// SingleCtx is like Single but the request to the server is cancelled
+// when ctx is done
+func (q *abstractDocumentQuery) SingleCtx(ctx context.Context, result interface{}) error {
 	if q.err != nil {
 		return q.err
 	}
@@ -2181,7 +2430,7 @@ func (q *abstractDocumentQuery) Single(result interface{}) error {
 	// create a pointer to a slice. executeQueryOperation creates the actual slice
 	sliceType := reflect.SliceOf(tp)
 	slicePtr := reflect.New(sliceType)
-	err := q.executeQueryOperation(slicePtr.Interface(), 2)
+	err := q.executeQueryOperation(ctx, slicePtr.Interface(), 2)
 	if err != nil {
 		return err
 	}
@@ -2193,12 +2442,19 @@ func (q *abstractDocumentQuery) Single(result interface{}) error {
 	return setInterfaceToValue(result, el.Interface())
 }
 
+// Count returns number of results in a query
 func (q *abstractDocumentQuery) Count() (int, error) {
+	return q.CountCtx(context.Background())
+}
+
+// CountCtx is like Count but the request to the server is cancelled
+// when ctx is done
+func (q *abstractDocumentQuery) CountCtx(ctx context.Context) (int, error) {
 	if q.err != nil {
 		return 0, q.err
 	}
 	q.take(0)
-	queryResult, err := q.getQueryResult()
+	queryResult, err := q.getQueryResult(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -2207,6 +2463,12 @@ func (q *abstractDocumentQuery) Count() (int, error) {
 
 // Any returns true if query returns at least one result
 func (q *abstractDocumentQuery) Any() (bool, error) {
+	return q.AnyCtx(context.Background())
+}
+
+// AnyCtx is like Any but the request to the server is cancelled
+// when ctx is done
+func (q *abstractDocumentQuery) AnyCtx(ctx context.Context) (bool, error) {
 	if q.err != nil {
 		return false, q.err
 	}
@@ -2215,7 +2477,7 @@ func (q *abstractDocumentQuery) Any() (bool, error) {
 
 		q.take(1)
 
-		err := q.initSync()
+		err := q.initSync(ctx)
 		if err != nil {
 			return false, err
 		}
@@ -2223,19 +2485,23 @@ func (q *abstractDocumentQuery) Any() (bool, error) {
 	}
 
 	q.take(0)
-	queryResult, err := q.getQueryResult()
+	queryResult, err := q.getQueryResult(ctx)
 	if err != nil {
 		return false, err
 	}
 	return queryResult.TotalResults > 0, nil
 }
 
-func (q *abstractDocumentQuery) executeQueryOperation(results interface{}, take int) error {
+func (q *abstractDocumentQuery) executeQueryOperation(ctx context.Context, results interface{}, take int) error {
 	if take != -1 && (q.pageSize == nil || *q.pageSize > take) {
 		q.take(take)
 	}
 
-	err := q.initSync()
+	if q.queryOperation == nil {
+		// results is *[]<type>
+		q.resultType = reflect.TypeOf(results).Elem().Elem()
+	}
+	err := q.initSync(ctx)
 	if err != nil {
 		return err
 	}
@@ -3,6 +3,18 @@ package ravendb
 // Note: IndexCreationTask combines functionality of Java's
 // AbstractIndexCreationTask and AbstractMultiMapIndexCreationTask
 
+// IAbstractIndexCreationTask is implemented by IndexCreationTask and
+// JavaScriptIndexCreationTask
+type IAbstractIndexCreationTask interface {
+	GetIndexName() string
+	CreateIndexDefinition() *IndexDefinition
+	Execute(store *DocumentStore, conventions *DocumentConventions, database string) error
+}
+
+var (
+	_ IAbstractIndexCreationTask = &IndexCreationTask{}
+)
+
 // IndexCreationTask is for creating IndexDefinition
 type IndexCreationTask struct {
 	// for a single map index, set Map
@@ -74,6 +86,11 @@ func (t *IndexCreationTask) CreateIndexDefinition() *IndexDefinition {
 	return def
 }
 
+// GetIndexName returns the name of the index
+func (t *IndexCreationTask) GetIndexName() string {
+	return t.IndexName
+}
+
 // IsMapReduce returns true if this is map-reduce index
 func (t *IndexCreationTask) IsMapReduce() bool {
 	return t.Reduce != ""
@@ -0,0 +1,93 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &AddEtlOperation{}
+)
+
+// AddEtlOperation creates a new ETL task
+type AddEtlOperation struct {
+	configuration interface{}
+
+	Command *AddEtlCommand
+}
+
+// NewAddEtlOperation returns new AddEtlOperation.
+// configuration should be *RavenEtlConfiguration or *SqlEtlConfiguration
+func NewAddEtlOperation(configuration interface{}) (*AddEtlOperation, error) {
+	if err := checkEtlConfiguration(configuration); err != nil {
+		return nil, err
+	}
+	return &AddEtlOperation{
+		configuration: configuration,
+	}, nil
+}
+
+// GetCommand returns a command
+func (o *AddEtlOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewAddEtlCommand(o.configuration)
+	return o.Command, nil
+}
+
+func checkEtlConfiguration(configuration interface{}) error {
+	switch v := configuration.(type) {
+	case *RavenEtlConfiguration:
+		if v != nil {
+			return nil
+		}
+	case *SqlEtlConfiguration:
+		if v != nil {
+			return nil
+		}
+	case nil:
+	default:
+		return newIllegalArgumentError("configuration should be *RavenEtlConfiguration or *SqlEtlConfiguration, is %T", configuration)
+	}
+	return newIllegalArgumentError("Configuration cannot be null")
+}
+
+// AddEtlOperationResult is a result of AddEtlCommand
+type AddEtlOperationResult struct {
+	RaftCommandIndex int64 `json:"RaftCommandIndex"`
+	TaskID           int64 `json:"TaskId"`
+}
+
+var _ RavenCommand = &AddEtlCommand{}
+
+// AddEtlCommand creates a new ETL task
+type AddEtlCommand struct {
+	RavenCommandBase
+
+	configuration interface{}
+
+	Result *AddEtlOperationResult
+}
+
+// NewAddEtlCommand returns new AddEtlCommand
+func NewAddEtlCommand(configuration interface{}) *AddEtlCommand {
+	return &AddEtlCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		configuration: configuration,
+	}
+}
+
+func (c *AddEtlCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/etl"
+
+	d, err := jsonMarshal(c.configuration)
+	if err != nil {
+		return nil, err
+	}
+	return newHttpPut(url, d)
+}
+
+func (c *AddEtlCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
@@ -0,0 +1,24 @@
+package ravendb
+
+// AnalyzerDefinition describes a custom analyzer. Code is C# source of a
+// class deriving from Lucene.Net.Analysis.Analyzer, named like the analyzer.
+// Indexes use it by name e.g. with IndexCreationTask.Analyze()
+type AnalyzerDefinition struct {
+	Name string `json:"Name"`
+	Code string `json:"Code"`
+}
+
+func checkAnalyzersToAdd(analyzersToAdd []*AnalyzerDefinition) error {
+	if len(analyzersToAdd) == 0 {
+		return newIllegalArgumentError("analyzersToAdd cannot be empty")
+	}
+	for _, analyzer := range analyzersToAdd {
+		if analyzer == nil {
+			return newIllegalArgumentError("Analyzer cannot be null")
+		}
+		if analyzer.Name == "" {
+			return newIllegalArgumentError("Analyzer name cannot be empty")
+		}
+	}
+	return nil
+}
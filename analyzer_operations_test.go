@@ -0,0 +1,74 @@
+package ravendb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzerOperations(t *testing.T) {
+	srv := newFakeServer(t)
+	srv.respond("/databases/db/admin/analyzers", "")
+	srv.respond("/admin/analyzers", "")
+	re := srv.newRequestExecutor(t, nil)
+	conventions := re.GetConventions()
+
+	analyzer := &AnalyzerDefinition{
+		Name: "MyAnalyzer",
+		Code: "public class MyAnalyzer : StandardAnalyzer {}",
+	}
+	var commands []RavenCommand
+	putOp, err := NewPutAnalyzersOperation(analyzer)
+	require.NoError(t, err)
+	putServerWideOp, err := NewPutServerWideAnalyzersOperation(analyzer)
+	require.NoError(t, err)
+	deleteOp, err := NewDeleteAnalyzerOperation("MyAnalyzer")
+	require.NoError(t, err)
+	deleteServerWideOp, err := NewDeleteServerWideAnalyzerOperation("MyAnalyzer")
+	require.NoError(t, err)
+	for _, op := range []IServerOperation{putOp, putServerWideOp, deleteOp, deleteServerWideOp} {
+		cmd, err := op.GetCommand(conventions)
+		require.NoError(t, err)
+		commands = append(commands, cmd)
+	}
+	for _, cmd := range commands {
+		require.NoError(t, re.ExecuteCommand(cmd, nil))
+	}
+
+	expected := []string{
+		"PUT /databases/db/admin/analyzers",
+		"PUT /admin/analyzers",
+		"DELETE /databases/db/admin/analyzers?name=MyAnalyzer",
+		"DELETE /admin/analyzers?name=MyAnalyzer",
+	}
+	var requests []string
+	var bodies []map[string]interface{}
+	for _, r := range srv.getRequests() {
+		requests = append(requests, r.Method+" "+r.URL.String())
+		if r.Body != nil {
+			bodies = append(bodies, r.Body)
+		}
+	}
+	assert.Equal(t, expected, requests)
+	require.Equal(t, 2, len(bodies))
+	expectedBody := map[string]interface{}{
+		"Analyzers": []interface{}{
+			map[string]interface{}{"Name": "MyAnalyzer", "Code": analyzer.Code},
+		},
+	}
+	for _, body := range bodies {
+		assert.Equal(t, expectedBody, body)
+	}
+
+	_, err = NewPutAnalyzersOperation()
+	assert.Error(t, err)
+	_, err = NewPutAnalyzersCommand(conventions, []*AnalyzerDefinition{{Code: analyzer.Code}})
+	assert.Error(t, err)
+	_, err = NewPutServerWideAnalyzersCommand(conventions, []*AnalyzerDefinition{nil})
+	assert.Error(t, err)
+	_, err = NewDeleteAnalyzerOperation("")
+	assert.Error(t, err)
+	_, err = NewDeleteServerWideAnalyzerOperation("")
+	assert.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package ravendb
+
+// BackupType describes a type of a backup
+type BackupType = string
+
+const (
+	BackupTypeBackup   = "Backup"
+	BackupTypeSnapshot = "Snapshot"
+)
+
+// EncryptionMode describes how a backup is encrypted
+type EncryptionMode = string
+
+const (
+	EncryptionModeNone           = "None"
+	EncryptionModeUseDatabaseKey = "UseDatabaseKey"
+	EncryptionModeUseProvidedKey = "UseProvidedKey"
+)
+
+// SnapshotCompressionLevel describes compression of a snapshot backup
+type SnapshotCompressionLevel = string
+
+const (
+	SnapshotCompressionLevelOptimal       = "Optimal"
+	SnapshotCompressionLevelFastest       = "Fastest"
+	SnapshotCompressionLevelNoCompression = "NoCompression"
+)
+
+// BackupEncryptionSettings describes encryption of a backup
+type BackupEncryptionSettings struct {
+	Key            string         `json:"Key,omitempty"`
+	EncryptionMode EncryptionMode `json:"EncryptionMode"`
+}
+
+// SnapshotSettings describes settings of a snapshot backup
+type SnapshotSettings struct {
+	CompressionLevel SnapshotCompressionLevel `json:"CompressionLevel"`
+}
+
+// LocalSettings describes a backup destination in a folder on the server
+type LocalSettings struct {
+	Disabled   bool   `json:"Disabled"`
+	FolderPath string `json:"FolderPath"`
+}
+
+// BackupConfiguration describes a backup and is an argument to BackupOperation
+type BackupConfiguration struct {
+	BackupType               BackupType                `json:"BackupType"`
+	SnapshotSettings         *SnapshotSettings         `json:"SnapshotSettings"`
+	BackupEncryptionSettings *BackupEncryptionSettings `json:"BackupEncryptionSettings"`
+	LocalSettings            *LocalSettings            `json:"LocalSettings"`
+}
+
+// NewBackupConfiguration returns a configuration of a backup of a given
+// type to a local folder
+func NewBackupConfiguration(backupType BackupType, folderPath string) *BackupConfiguration {
+	return &BackupConfiguration{
+		BackupType: backupType,
+		LocalSettings: &LocalSettings{
+			FolderPath: folderPath,
+		},
+	}
+}
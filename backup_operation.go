@@ -0,0 +1,76 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &BackupOperation{}
+)
+
+// BackupOperation runs a one-time backup that isn't tied to a periodic
+// backup task. Use MaintenanceOperationExecutor.SendAsync to wait for
+// the backup to finish
+type BackupOperation struct {
+	configuration *BackupConfiguration
+
+	Command *BackupCommand
+}
+
+// NewBackupOperation returns new BackupOperation
+func NewBackupOperation(configuration *BackupConfiguration) *BackupOperation {
+	return &BackupOperation{
+		configuration: configuration,
+	}
+}
+
+// GetCommand returns a command
+func (o *BackupOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewBackupCommand(o.configuration)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
+
+var _ RavenCommand = &BackupCommand{}
+
+// BackupCommand runs a one-time backup
+type BackupCommand struct {
+	RavenCommandBase
+
+	configuration *BackupConfiguration
+
+	Result *OperationIDResult
+}
+
+// NewBackupCommand returns new BackupCommand
+func NewBackupCommand(configuration *BackupConfiguration) (*BackupCommand, error) {
+	if configuration == nil {
+		return nil, newIllegalArgumentError("Configuration cannot be null")
+	}
+	cmd := &BackupCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		configuration: configuration,
+	}
+	return cmd, nil
+}
+
+func (c *BackupCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/backup"
+
+	d, err := jsonMarshal(c.configuration)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+func (c *BackupCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
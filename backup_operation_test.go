@@ -0,0 +1,87 @@
+package ravendb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupCommands(t *testing.T) {
+	srv := newFakeServer(t)
+	srv.respond("/databases/db/admin/periodic-backup", `{"RaftCommandIndex":10,"TaskId":3}`)
+	srv.respond("/databases/db/admin/backup/database", `{"ResponsibleNode":"A","OperationId":7}`)
+	srv.respond("/databases/db/admin/backup", `{"OperationId":8,"OperationNodeTag":"A"}`)
+	srv.respond("/periodic-backup/status", `{"Status":{"TaskId":3,"BackupType":"Backup","IsFull":true,"NodeTag":"A","LastFullBackup":"2020-05-01T10:20:30.1234567Z","LocalBackup":{"BackupDirectory":"/backups/db","FileName":"2020-05-01-10-20.ravendb-full-backup"},"LastOperationId":7}}`)
+	srv.respond("/admin/restore/database", `{"OperationId":9,"OperationNodeTag":"A"}`)
+	srv.respond("/databases/db/operations/state", `{"Status":"Completed","Result":null}`)
+	re := srv.newRequestExecutor(t, nil)
+	conventions := re.GetConventions()
+
+	configuration := &PeriodicBackupConfiguration{
+		BackupConfiguration:        *NewBackupConfiguration(BackupTypeBackup, "/backups"),
+		Name:                       "nightly",
+		FullBackupFrequency:        "0 2 * * 0",
+		IncrementalBackupFrequency: "0 2 * * 1-6",
+	}
+	updateOp := NewUpdatePeriodicBackupOperation(configuration)
+	cmd, err := updateOp.GetCommand(conventions)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, int64(3), updateOp.Command.Result.TaskID)
+	body := srv.lastRequest().Body
+	assert.Equal(t, "Backup", body["BackupType"])
+	assert.Equal(t, "nightly", body["Name"])
+	assert.Equal(t, "0 2 * * 0", body["FullBackupFrequency"])
+	assert.Equal(t, "/backups", body["LocalSettings"].(map[string]interface{})["FolderPath"])
+
+	cmd, err = NewStartBackupOperation(true, 3).GetCommand(conventions)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+	query := srv.lastRequest().URL.Query()
+	assert.Equal(t, "true", query.Get("isFullBackup"))
+	assert.Equal(t, "3", query.Get("taskId"))
+	id := getCommandOperationIDResult(cmd)
+	assert.Equal(t, &OperationIDResult{OperationID: 7, OperationNodeTag: "A"}, id)
+	op := NewOperation(re, nil, conventions, id.OperationID)
+	require.NoError(t, op.WaitForCompletion())
+	assert.Equal(t, "7", srv.lastRequest().URL.Query().Get("id"))
+
+	backupOp := NewBackupOperation(NewBackupConfiguration(BackupTypeSnapshot, "/backups/once"))
+	cmd, err = backupOp.GetCommand(conventions)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, int64(8), getCommandOperationIDResult(cmd).OperationID)
+	body = srv.lastRequest().Body
+	assert.Equal(t, "Snapshot", body["BackupType"])
+	assert.Equal(t, "/backups/once", body["LocalSettings"].(map[string]interface{})["FolderPath"])
+
+	statusOp := NewGetPeriodicBackupStatusOperation(3)
+	cmd, err = statusOp.GetCommand(conventions)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, "name=db&taskId=3", srv.lastRequest().URL.RawQuery)
+	status := statusOp.Command.Result.Status
+	require.NotNil(t, status)
+	assert.True(t, status.IsFull)
+	assert.Equal(t, "A", status.NodeTag)
+	assert.Equal(t, "/backups/db", status.LocalBackup.BackupDirectory)
+	assert.Equal(t, 2020, time.Time(*status.LastFullBackup).Year())
+	assert.Equal(t, int64(7), *status.LastOperationID)
+
+	_, err = NewRestoreBackupOperation(&RestoreBackupConfiguration{DatabaseName: "restored"}).GetCommand(conventions)
+	assert.Error(t, err)
+	restoreOp := NewRestoreBackupOperation(&RestoreBackupConfiguration{
+		DatabaseName:   "restored",
+		BackupLocation: "/backups/db",
+	})
+	cmd, err = restoreOp.GetCommand(conventions)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, &OperationIDResult{OperationID: 9, OperationNodeTag: "A"}, getCommandOperationIDResult(cmd))
+	body = srv.lastRequest().Body
+	assert.Equal(t, "Local", body["Type"])
+	assert.Equal(t, "restored", body["DatabaseName"])
+	assert.Equal(t, "/backups/db", body["BackupLocation"])
+}
@@ -60,6 +60,7 @@ func newBatchCommand(conventions *DocumentConventions, commands []ICommandData,
 		disableAtomicDocumentWrites: disableAtomicDocumentWrites,
 		raftUniqueRequestId:         raftId,
 	}
+	cmd.CanCompress = true
 
 	for i := 0; i < len(commands); i++ {
 		command := commands[i]
@@ -115,6 +115,20 @@ func (b *BatchOperation) setResult(serverResult *JSONArrayResult) error {
 
 	}
 
+	// results of deferred commands
+	for commandIndex := b.sessionCommandsCount; commandIndex < len(result); commandIndex++ {
+		batchResult := result[commandIndex]
+		if batchResult == nil {
+			continue
+		}
+		typ, _ := jsonGetAsText(batchResult, "Type")
+		if typ == CommandCounters {
+			if err := b.session.updateCountersFromBatchResult(batchResult); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
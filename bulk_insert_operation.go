@@ -40,9 +40,16 @@ func NewBulkInsertCommand(id int64, stream io.Reader, useCompression bool) *Bulk
 
 func (c *BulkInsertCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
 	url := node.URL + "/databases/" + node.Database + "/bulk_insert?id=" + i64toa(c.id)
-	// TODO: implement compression. It must be attached to the writer
-	//message.setEntity(useCompression ? new GzipCompressingEntity(_stream) : _stream)
-	return newHttpPostReader(url, c.stream)
+	request, err := newHttpPostReader(url, c.stream)
+	if err != nil {
+		return nil, err
+	}
+	if c.useCompression {
+		if err = compressRequestBody(request, CompressionAlgorithmGzip); err != nil {
+			return nil, err
+		}
+	}
+	return request, nil
 }
 
 func (c *BulkInsertCommand) SetResponse(response []byte, fromCache bool) error {
@@ -100,6 +107,7 @@ func NewBulkInsertOperation(database string, store *DocumentStore) *BulkInsertOp
 		currentWriter:               writer,
 		operationID:                 -1,
 		first:                       true,
+		useCompression:              re.GetConventions().isCompressionEnabled(),
 	}
 	return res
 }
@@ -1,6 +1,7 @@
 package ravendb
 
 import (
+	"context"
 	"sync"
 	"time"
 )
@@ -144,3 +145,22 @@ func (f *completableFuture) GetWithTimeout(dur time.Duration) (interface{}, erro
 	_, res, err = f.getState()
 	return res, err
 }
+
+// getWithContext is like Get but stops waiting when ctx is done, in which
+// case it returns ctx.Err(). The future itself is not cancelled.
+func (f *completableFuture) getWithContext(ctx context.Context) (interface{}, error) {
+	done, res, err := f.getState()
+	if done {
+		return res, err
+	}
+
+	select {
+	case <-f.signalCompletion:
+		// completed, will return the result
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	_, res, err = f.getState()
+	return res, err
+}
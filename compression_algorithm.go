@@ -0,0 +1,9 @@
+package ravendb
+
+// CompressionAlgorithm defines how request bodies sent to the server are compressed
+type CompressionAlgorithm = string
+
+const (
+	CompressionAlgorithmNone = "None"
+	CompressionAlgorithmGzip = "Gzip"
+)
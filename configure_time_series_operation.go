@@ -0,0 +1,61 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var _ IMaintenanceOperation = &ConfigureTimeSeriesOperation{}
+
+// ConfigureTimeSeriesOperation sets retention and rollup policies of time series
+type ConfigureTimeSeriesOperation struct {
+	configuration *TimeSeriesConfiguration
+	Command       *ConfigureTimeSeriesCommand
+}
+
+// NewConfigureTimeSeriesOperation returns new ConfigureTimeSeriesOperation
+func NewConfigureTimeSeriesOperation(configuration *TimeSeriesConfiguration) (*ConfigureTimeSeriesOperation, error) {
+	if configuration == nil {
+		return nil, newIllegalArgumentError("Configuration cannot be null")
+	}
+	return &ConfigureTimeSeriesOperation{
+		configuration: configuration,
+	}, nil
+}
+
+// GetCommand returns a command
+func (o *ConfigureTimeSeriesOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	d, err := jsonMarshal(o.configuration)
+	if err != nil {
+		return nil, err
+	}
+	o.Command = &ConfigureTimeSeriesCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+		configuration:    d,
+	}
+	return o.Command, nil
+}
+
+// ConfigureTimeSeriesCommand represents a command for configuring time series
+type ConfigureTimeSeriesCommand struct {
+	RavenCommandBase
+	configuration []byte
+	Result        *ConfigureTimeSeriesOperationResult
+}
+
+func (c *ConfigureTimeSeriesCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/timeseries/config"
+
+	return NewHttpPost(url, c.configuration)
+}
+
+func (c *ConfigureTimeSeriesCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
+
+// ConfigureTimeSeriesOperationResult is a result of ConfigureTimeSeriesOperation
+type ConfigureTimeSeriesOperationResult struct {
+	RaftCommandIndex *int64 `json:"RaftCommandIndex"`
+}
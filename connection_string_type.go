@@ -1,6 +1,6 @@
 package ravendb
 
-// TODO: only used in ConnectionString which is unused
+// ConnectionStringType describes a type of ConnectionString
 type ConnectionStringType = string
 
 const (
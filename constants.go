@@ -12,6 +12,7 @@ const (
 	MetadataIDProperty             = "Id"
 	MetadataFlags                  = "@flags"
 	MetadataAttachments            = "@attachments"
+	MetadataCounters               = "@counters"
 	MetadataInddexScore            = "@index-score"
 	MetadataLastModified           = "@last-modified"
 	MetadataRavenGoType            = "Raven-Go-Type"
@@ -19,13 +20,12 @@ const (
 	MetadataExpires                = "@expires"
 	MetadataAllDocumentsCollection = "@all_docs"
 
-	IndexingSideBySideIndexNamePrefix = "ReplacementOf/"
-	IndexingFieldNameDocumentID       = "id()"
-	IndexingFieldNameReduceKeyHash    = "hash(key())"
-	IndexingFieldNameReduceKeyValue   = "key()"
-	IndexingFieldAllFields            = "__all_fields"
-	IndexingFieldsNameSpatialShare    = "spatial(shape)"
-	//TBD CUSTOM_SORT_FIELD_NAME = "__customSort";
+	IndexingSideBySideIndexNamePrefix      = "ReplacementOf/"
+	IndexingFieldNameDocumentID            = "id()"
+	IndexingFieldNameReduceKeyHash         = "hash(key())"
+	IndexingFieldNameReduceKeyValue        = "key()"
+	IndexingFieldAllFields                 = "__all_fields"
+	IndexingFieldsNameSpatialShare         = "spatial(shape)"
 	IndexingSpatialDefaultDistnaceErrorPct = 0.025
 
 	headersRequestTime                = "Raven-Request-Time"
@@ -0,0 +1,69 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IOperation = &CounterBatchOperation{}
+)
+
+// CounterBatchOperation applies counter operations to multiple documents
+type CounterBatchOperation struct {
+	Command *CounterBatchCommand
+
+	counterBatch *CounterBatch
+}
+
+// NewCounterBatchOperation returns new CounterBatchOperation
+func NewCounterBatchOperation(counterBatch *CounterBatch) *CounterBatchOperation {
+	return &CounterBatchOperation{
+		counterBatch: counterBatch,
+	}
+}
+
+func (o *CounterBatchOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewCounterBatchCommand(o.counterBatch)
+	return o.Command, err
+}
+
+var _ RavenCommand = &CounterBatchCommand{}
+
+// CounterBatchCommand sends a CounterBatch to the server
+type CounterBatchCommand struct {
+	RavenCommandBase
+
+	counterBatch *CounterBatch
+
+	Result *CountersDetail
+}
+
+// NewCounterBatchCommand returns new CounterBatchCommand
+func NewCounterBatchCommand(counterBatch *CounterBatch) (*CounterBatchCommand, error) {
+	if counterBatch == nil {
+		return nil, newIllegalArgumentError("CounterBatch cannot be null")
+	}
+	return &CounterBatchCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		counterBatch: counterBatch,
+	}, nil
+}
+
+func (c *CounterBatchCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/counters"
+
+	d, err := jsonMarshal(c.counterBatch)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+func (c *CounterBatchCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return nil
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
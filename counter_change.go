@@ -0,0 +1,23 @@
+package ravendb
+
+type CounterChangeTypes = string
+
+const (
+	CounterChangeNone      = "None"
+	CounterChangePut       = "Put"
+	CounterChangeDelete    = "Delete"
+	CounterChangeIncrement = "Increment"
+)
+
+// CounterChange describes a change to a counter. Can be used as DatabaseChange.
+type CounterChange struct {
+	Type         CounterChangeTypes
+	Name         string
+	Value        int64
+	DocumentID   string `json:"DocumentId"`
+	ChangeVector *string
+}
+
+func (c *CounterChange) String() string {
+	return c.Type + " on counter " + c.Name + " of " + c.DocumentID
+}
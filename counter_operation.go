@@ -0,0 +1,47 @@
+package ravendb
+
+// CounterOperationType describes the kind of operation applied to a counter
+type CounterOperationType = string
+
+const (
+	CounterOperationTypeNone      = "None"
+	CounterOperationTypeIncrement = "Increment"
+	CounterOperationTypeDelete    = "Delete"
+	CounterOperationTypeGet       = "Get"
+	CounterOperationTypePut       = "Put"
+)
+
+// CounterOperation describes a single operation on a counter
+type CounterOperation struct {
+	Type        CounterOperationType `json:"Type"`
+	CounterName string               `json:"CounterName"`
+	Delta       int64                `json:"Delta"`
+}
+
+// DocumentCountersOperation groups counter operations on a single document
+type DocumentCountersOperation struct {
+	DocumentID string              `json:"DocumentId"`
+	Operations []*CounterOperation `json:"Operations"`
+}
+
+// CounterBatch describes counter operations on multiple documents
+type CounterBatch struct {
+	ReplyWithAllNodesValues bool                         `json:"ReplyWithAllNodesValues"`
+	Documents               []*DocumentCountersOperation `json:"Documents"`
+	FromEtl                 bool                         `json:"FromEtl"`
+}
+
+// CounterDetail describes value of a counter
+type CounterDetail struct {
+	DocumentID  string `json:"DocumentId"`
+	CounterName string `json:"CounterName"`
+	TotalValue  int64  `json:"TotalValue"`
+	// per-node values, only returned when explicitly requested
+	CounterValues map[string]int64 `json:"CounterValues"`
+}
+
+// CountersDetail is a result of counter operations
+type CountersDetail struct {
+	// an entry is nil if a requested counter doesn't exist
+	Counters []*CounterDetail `json:"Counters"`
+}
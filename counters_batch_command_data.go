@@ -0,0 +1,63 @@
+package ravendb
+
+var _ ICommandData = &CountersBatchCommandData{}
+
+// CountersBatchCommandData represents counter operations on a single document
+// sent as part of a batch
+type CountersBatchCommandData struct {
+	*CommandData
+
+	fromEtl  bool
+	counters *DocumentCountersOperation
+}
+
+// NewCountersBatchCommandData returns new CountersBatchCommandData
+func NewCountersBatchCommandData(documentID string, counterOperations []*CounterOperation) (*CountersBatchCommandData, error) {
+	if stringIsBlank(documentID) {
+		return nil, newIllegalArgumentError("DocumentId cannot be empty")
+	}
+	if len(counterOperations) == 0 {
+		return nil, newIllegalArgumentError("counterOperations cannot be empty")
+	}
+
+	res := &CountersBatchCommandData{
+		CommandData: &CommandData{
+			ID:   documentID,
+			Type: CommandCounters,
+		},
+		counters: &DocumentCountersOperation{
+			DocumentID: documentID,
+			Operations: counterOperations,
+		},
+	}
+	return res, nil
+}
+
+func (d *CountersBatchCommandData) hasOperation(typ CounterOperationType, counterName string) bool {
+	for _, op := range d.counters.Operations {
+		if op.Type == typ && op.CounterName == counterName {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *CountersBatchCommandData) hasDelete(counterName string) bool {
+	return d.hasOperation(CounterOperationTypeDelete, counterName)
+}
+
+func (d *CountersBatchCommandData) hasIncrement(counterName string) bool {
+	return d.hasOperation(CounterOperationTypeIncrement, counterName)
+}
+
+func (d *CountersBatchCommandData) serialize(conventions *DocumentConventions) (interface{}, error) {
+	res := map[string]interface{}{
+		"Id":       d.ID,
+		"Counters": d.counters,
+		"Type":     d.Type,
+	}
+	if d.fromEtl {
+		res["FromEtl"] = true
+	}
+	return res, nil
+}
@@ -2,7 +2,6 @@ package ravendb
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -18,25 +17,15 @@ import (
 
 // Note: in Java IChangesConnectionState hides changeSubscribers
 
-// enableDatabaseChangesDebugOutput enables debug logging of
-// database_changes.go code
-var enableDatabaseChangesDebugOutput bool
-
-// for debugging DatabaseChanges code
-func dcdbg(format string, args ...interface{}) {
-	// change to true to enable debug output
-	if enableDatabaseChangesDebugOutput {
-		fmt.Printf(format, args...)
-	}
-}
-
 type changeSubscribers struct {
 	name           string // is key of DatabaseChanges.subscribers
 	watchCommand   string
 	unwatchCommand string
 	commandValue   string
+	commandValues  []string
 
 	onDocumentChange        sync.Map // int -> func(*DocumentChange)
+	onCounterChange         sync.Map // int -> func(*CounterChange)
 	onIndexChange           sync.Map // int -> func(*IndexChange)
 	onOperationStatusChange sync.Map // int -> func(*OperationStatusChange)
 
@@ -78,6 +67,16 @@ func (s *changeSubscribers) unregisterOnOperationStatusChange(id int) {
 	s.onOperationStatusChange.Delete(id)
 }
 
+func (s *changeSubscribers) registerOnCounterChange(fn func(*CounterChange)) int {
+	id := s.getNextID()
+	s.onCounterChange.Store(id, fn)
+	return id
+}
+
+func (s *changeSubscribers) unregisterOnCounterChange(id int) {
+	s.onCounterChange.Delete(id)
+}
+
 func (s *changeSubscribers) sendDocumentChange(change *DocumentChange) {
 	s.onDocumentChange.Range(func(k, v interface{}) bool {
 		f := v.(func(documentChange *DocumentChange))
@@ -86,6 +85,14 @@ func (s *changeSubscribers) sendDocumentChange(change *DocumentChange) {
 	})
 }
 
+func (s *changeSubscribers) sendCounterChange(change *CounterChange) {
+	s.onCounterChange.Range(func(k, v interface{}) bool {
+		f := v.(func(*CounterChange))
+		f(change)
+		return true
+	})
+}
+
 func (s *changeSubscribers) sendIndexChange(change *IndexChange) {
 	s.onIndexChange.Range(func(k, v interface{}) bool {
 		f := v.(func(documentChange *IndexChange))
@@ -114,14 +121,16 @@ func (s *changeSubscribers) hasRegisteredHandlers() bool {
 	s.onDocumentChange.Range(fn)
 	s.onIndexChange.Range(fn)
 	s.onOperationStatusChange.Range(fn)
+	s.onCounterChange.Range(fn)
 	return hasHandlers
 }
 
-func newDatabaseChangesCommand(id int, command string, value string) *databaseChangesCommand {
+func newDatabaseChangesCommand(id int, command string, value string, values []string) *databaseChangesCommand {
 	return &databaseChangesCommand{
 		id:        id,
 		command:   command,
 		value:     value,
+		values:    values,
 		timeStart: time.Now(),
 		ch:        make(chan bool, 1), // don't block the sender
 	}
@@ -132,6 +141,7 @@ type databaseChangesCommand struct {
 	id      int
 	command string
 	value   string
+	values  []string
 
 	// used to wait for notifications
 	timeStart    time.Time
@@ -228,7 +238,7 @@ func newDatabaseChanges(requestExecutor *RequestExecutor, databaseName string, o
 	go func() {
 		_, err := requestExecutor.getPreferredNode()
 		if err != nil {
-			dcdbg("newDatabaseChanges: getPreferredNode() failed with %s\n", err)
+			res.logger().Warn("changes: failed to get preferred node", "database", databaseName, "error", err)
 			res.notifyAboutError(err)
 			res.chWorkCompleted <- err
 			close(res.chWorkCompleted)
@@ -243,19 +253,20 @@ func newDatabaseChanges(requestExecutor *RequestExecutor, databaseName string, o
 	return res
 }
 
+func (c *DatabaseChanges) logger() Logger {
+	return c.conventions.getLogger()
+}
+
 func (c *DatabaseChanges) EnsureConnectedNow() error {
 	select {
 	case <-c.ctxCancel.Done():
-		dcdbg("DatabaseChanges(): EnsureConnectedNow(): is closed\n")
 		return errors.New("DatabaseChanges.EnsureConnectedNow(): Close() has been called")
 	case err := <-c.chWorkCompleted:
-		dcdbg("DatabaseChanges(): EnsureConnectedNow(): chnWorkCompleted notified\n")
 		return err
 	case err := <-c.chIsConnected:
-		dcdbg("DatabaseChanges(): EnsureConnectedNow(): chanIsConnected notified\n")
 		return err
 	case <-time.After(time.Second * 15):
-		dcdbg("DatabaseChanges(): EnsureConnectedNow(): timed out waiting for connection\n")
+		c.logger().Warn("changes: timed out waiting for connection", "database", c.database)
 		return errors.New("timed out waiting for connection")
 	}
 }
@@ -459,6 +470,104 @@ func (c *DatabaseChanges) ForDocumentsInCollectionOfType(clazz reflect.Type, cb
 	return c.ForDocumentsInCollection(collectionName, cb)
 }
 
+// ForAllCounters registers a callback that will be called for changes of all counters.
+// It returns a function to call to unregister the callback.
+func (c *DatabaseChanges) ForAllCounters(cb func(*CounterChange)) (CancelFunc, error) {
+	subscribers, err := c.getOrAddSubscribers("all-counters", "watch-counters", "unwatch-counters", "")
+	if err != nil {
+		return nil, err
+	}
+
+	idx := subscribers.registerOnCounterChange(cb)
+	cancel := func() {
+		subscribers.unregisterOnCounterChange(idx)
+		c.maybeDisconnectSubscribers(subscribers)
+	}
+	return cancel, nil
+}
+
+// ForCounter registers a callback that will be called for changes of counters with a given name
+// in any document. It returns a function to call to unregister the callback.
+func (c *DatabaseChanges) ForCounter(counterName string, cb func(*CounterChange)) (CancelFunc, error) {
+	if stringIsBlank(counterName) {
+		return nil, newIllegalArgumentError("CounterName cannot be empty")
+	}
+
+	subscribers, err := c.getOrAddSubscribers("counter/"+counterName, "watch-counter", "unwatch-counter", counterName)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := func(change *CounterChange) {
+		if strings.EqualFold(change.Name, counterName) {
+			cb(change)
+		}
+	}
+
+	idx := subscribers.registerOnCounterChange(filtered)
+	cancel := func() {
+		subscribers.unregisterOnCounterChange(idx)
+		c.maybeDisconnectSubscribers(subscribers)
+	}
+	return cancel, nil
+}
+
+// ForCounterOfDocument registers a callback that will be called for changes of a given counter
+// of a given document. It returns a function to call to unregister the callback.
+func (c *DatabaseChanges) ForCounterOfDocument(documentID string, counterName string, cb func(*CounterChange)) (CancelFunc, error) {
+	if stringIsBlank(documentID) {
+		return nil, newIllegalArgumentError("DocumentId cannot be empty")
+	}
+	if stringIsBlank(counterName) {
+		return nil, newIllegalArgumentError("CounterName cannot be empty")
+	}
+
+	name := "document/" + documentID + "/counter/" + counterName
+	subscribers, err := c.getOrAddSubscribersWithValues(name, "watch-document-counter", "unwatch-document-counter", []string{documentID, counterName})
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := func(change *CounterChange) {
+		if strings.EqualFold(change.DocumentID, documentID) && strings.EqualFold(change.Name, counterName) {
+			cb(change)
+		}
+	}
+
+	idx := subscribers.registerOnCounterChange(filtered)
+	cancel := func() {
+		subscribers.unregisterOnCounterChange(idx)
+		c.maybeDisconnectSubscribers(subscribers)
+	}
+	return cancel, nil
+}
+
+// ForCountersOfDocument registers a callback that will be called for changes of all counters
+// of a given document. It returns a function to call to unregister the callback.
+func (c *DatabaseChanges) ForCountersOfDocument(documentID string, cb func(*CounterChange)) (CancelFunc, error) {
+	if stringIsBlank(documentID) {
+		return nil, newIllegalArgumentError("DocumentId cannot be empty")
+	}
+
+	subscribers, err := c.getOrAddSubscribers("document/"+documentID+"/counter", "watch-document-counters", "unwatch-document-counters", documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := func(change *CounterChange) {
+		if strings.EqualFold(change.DocumentID, documentID) {
+			cb(change)
+		}
+	}
+
+	idx := subscribers.registerOnCounterChange(filtered)
+	cancel := func() {
+		subscribers.unregisterOnCounterChange(idx)
+		c.maybeDisconnectSubscribers(subscribers)
+	}
+	return cancel, nil
+}
+
 func (c *DatabaseChanges) invokeConnectionStatusChanged() {
 	// make a copy of callers so that we can call outside of a lock
 	c.mu.Lock()
@@ -491,7 +600,7 @@ func (c *DatabaseChanges) cancelOutstandingCommands() {
 	rangeFn := func(key, val interface{}) bool {
 		cmd := val.(*databaseChangesCommand)
 		cmd.confirm(true)
-		dcdbg("DatabaseChanges: cancelled outstanding command %d '%s %s'\n", cmd.id, cmd.command, cmd.value)
+		c.logger().Debug("changes: cancelled outstanding command", "id", cmd.id, "command", fmtDCCommand(cmd.command, cmd.value))
 		c.outstandingCommands.Delete(key)
 		return true
 	}
@@ -500,22 +609,14 @@ func (c *DatabaseChanges) cancelOutstandingCommands() {
 
 // Close closes DatabaseChanges and release its resources
 func (c *DatabaseChanges) Close() {
-	dcdbg("DatabaseChanges: Close()\n")
-	//debug.PrintStack()
-	select {
-	case <-c.chWorkCompleted:
-		dcdbg("DatabaseChanges.Close(): has already been closed because chanWorkCompleted notified\n")
-	default:
-		// no-op
-	}
-
+	c.logger().Debug("changes: closing", "database", c.database)
 	c.doWorkCancel()
 	c.cancelOutstandingCommands()
 
 	select {
 	case <-c.chWorkCompleted:
 	case <-time.After(time.Second * 5):
-		dcdbg("DatabaseChanges.Close(): timed out waiting for chanWorkCompleted\n")
+		c.logger().Warn("changes: timed out waiting for connection to close", "database", c.database)
 	}
 
 	if c.onClose != nil {
@@ -531,6 +632,15 @@ func fmtDCCommand(cmd, value string) string {
 }
 
 func (c *DatabaseChanges) getOrAddSubscribers(name string, watchCommand string, unwatchCommand string, value string) (*changeSubscribers, error) {
+	return c.getOrAddSubscribersFull(name, watchCommand, unwatchCommand, value, nil)
+}
+
+// for commands that take multiple parameters
+func (c *DatabaseChanges) getOrAddSubscribersWithValues(name string, watchCommand string, unwatchCommand string, values []string) (*changeSubscribers, error) {
+	return c.getOrAddSubscribersFull(name, watchCommand, unwatchCommand, "", values)
+}
+
+func (c *DatabaseChanges) getOrAddSubscribersFull(name string, watchCommand string, unwatchCommand string, value string, values []string) (*changeSubscribers, error) {
 	subscribersI, ok := c.subscribers.Load(name)
 
 	if ok {
@@ -542,6 +652,7 @@ func (c *DatabaseChanges) getOrAddSubscribers(name string, watchCommand string,
 		watchCommand:   watchCommand,
 		unwatchCommand: unwatchCommand,
 		commandValue:   value,
+		commandValues:  values,
 	}
 	c.subscribers.Store(name, subscribers)
 	if err := c.connectSubscribers(subscribers); err != nil {
@@ -557,24 +668,24 @@ func (c *DatabaseChanges) maybeDisconnectSubscribers(subscribers *changeSubscrib
 }
 
 func (c *DatabaseChanges) disconnectSubscribers(subscribers *changeSubscribers) {
-	_ = c.send(subscribers.unwatchCommand, subscribers.commandValue, false)
+	_ = c.send(subscribers.unwatchCommand, subscribers.commandValue, subscribers.commandValues, false)
 	// ignoring error: if we are not connected then we unsubscribed
 	// already because connections drops with all subscriptions
 	c.subscribers.Delete(subscribers.name)
 }
 
 func (c *DatabaseChanges) connectSubscribers(subscribers *changeSubscribers) error {
-	return c.send(subscribers.watchCommand, subscribers.commandValue, true)
+	return c.send(subscribers.watchCommand, subscribers.commandValue, subscribers.commandValues, true)
 }
 
-func (c *DatabaseChanges) send(command, value string, waitForConfirmation bool) error {
+func (c *DatabaseChanges) send(command, value string, values []string, waitForConfirmation bool) error {
 	if c.isClosed() {
 		return errors.New("Send() called after Close()")
 	}
 
 	id := c.nextCommandID()
-	cmd := newDatabaseChangesCommand(id, command, value)
-	dcdbg("DatabaseChanges: Send(): command id: %d, command: '%s', wait: %v\n", id, fmtDCCommand(command, value), waitForConfirmation)
+	cmd := newDatabaseChangesCommand(id, command, value, values)
+	c.logger().Debug("changes: sending command", "id", id, "command", fmtDCCommand(command, value), "wait", waitForConfirmation)
 	if waitForConfirmation {
 		c.outstandingCommands.Store(id, cmd)
 	}
@@ -590,36 +701,33 @@ func (c *DatabaseChanges) send(command, value string, waitForConfirmation bool)
 	return nil
 }
 
-func startSendWorker(conn *websocket.Conn, chCommands chan *databaseChangesCommand) chan error {
+func startSendWorker(conn *websocket.Conn, chCommands chan *databaseChangesCommand, logger Logger) chan error {
 	chFailed := make(chan error, 1)
 	go func() {
-		dcdbg("starting a chCommands reading loop\n")
 		for cmd := range chCommands {
-			dcdbg("got command with id %d to Send. Command: %s, param: %s\n", cmd.id, cmd.command, cmd.value)
 			o := struct {
-				CommandID int    `json:"CommandId"`
-				Command   string `json:"Command"`
-				Param     string `json:"Param"`
+				CommandID int      `json:"CommandId"`
+				Command   string   `json:"Command"`
+				Param     string   `json:"Param"`
+				Params    []string `json:"Params,omitempty"`
 			}{
 				CommandID: cmd.id,
 				Command:   cmd.command,
 				Param:     cmd.value,
+				Params:    cmd.values,
 			}
 			err := conn.SetWriteDeadline(time.Now().Add(time.Second * 3))
 			if err != nil {
-				dcdbg("DatabaseChanges: SetWriteDeadline() failed with %s\n", err)
 				chFailed <- err
 				return
 			}
 			err = conn.WriteJSON(o)
 			if err != nil {
-				dcdbg("DatabaseChanges: conn.WriteJSON() failed with %s\n", err)
 				chFailed <- err
 				return
 			}
-			dcdbg("wrote command with id %d to socket\n", cmd.id)
+			logger.Debug("changes: wrote command", "id", cmd.id, "command", fmtDCCommand(cmd.command, cmd.value))
 		}
-		dcdbg("DatabaseChanges: Send worker finished\n")
 	}()
 	return chFailed
 }
@@ -656,12 +764,13 @@ func (c *DatabaseChanges) doWorkInner(ctx context.Context) (error, bool) {
 	cancel()
 
 	if err != nil {
-		dcdbg("DatabaseChanges: dialer.DialContext failed with '%s'\n", err)
+		c.logger().Warn("changes: failed to connect", "url", urlString, "error", err)
 		return err, false
 	}
+	c.logger().Info("changes: connected", "url", urlString)
 
 	var chWriterFailed chan error
-	chWriterFailed = startSendWorker(client, c.chCommands)
+	chWriterFailed = startSendWorker(client, c.chCommands, c.logger())
 	var chReaderFailed chan error
 	chReaderFailed = c.startProcessMessagesWorker(ctx, client)
 
@@ -682,15 +791,14 @@ func (c *DatabaseChanges) doWorkInner(ctx context.Context) (error, bool) {
 	err = nil
 	select {
 	case err = <-chWriterFailed:
-		dcdbg("DatabaseChanges: writer failed with '%s'\n", err)
+		c.logger().Warn("changes: failed to send a command", "url", urlString, "error", err)
 	case err = <-chReaderFailed:
 		if err != nil {
-			dcdbg("DatabaseChanges: reader failed with '%s'\n", err)
+			c.logger().Warn("changes: failed to read from connection", "url", urlString, "error", err)
 		} else {
-			dcdbg("DatabaseChanges: reader finished cleanly\n")
+			c.logger().Debug("changes: connection closed by the server", "url", urlString)
 		}
 	case <-ctx.Done():
-		dcdbg("cancellation requested\n")
 		shouldReconnect = false
 	}
 
@@ -708,26 +816,24 @@ func (c *DatabaseChanges) doWorkInner(ctx context.Context) (error, bool) {
 func (c *DatabaseChanges) doWork(ctx context.Context) error {
 	for {
 		err, shouldReconnect := c.doWorkInner(ctx)
-		if err != nil {
-			dcdbg("DatabaseChanges: doWorkInner() failed with '%s'\n", err)
-		}
 		c.cancelOutstandingCommands()
 		if !shouldReconnect {
 			return err
 		}
+		c.logger().Info("changes: reconnecting", "database", c.database, "error", err)
+		c.conventions.metrics.changesReconnected()
 		// wait before next retry
 		time.Sleep(time.Second)
 	}
 }
 
 func (c *DatabaseChanges) notifySubscribers(typ string, value interface{}) error {
-	dcdbg("DatabnaseChanges: notifySubscribers(): %s, %v\n", typ, value)
 	switch typ {
 	case "DocumentChange":
 		var documentChange *DocumentChange
 		err := decodeJSONAsStruct(value, &documentChange)
 		if err != nil {
-			dcdbg("notifySubscribers: '%s' decodeJSONAsStruct failed with %s\n", typ, err)
+			c.logger().Error("changes: failed to decode notification", "type", typ, "error", err)
 			return err
 		}
 		fn := func(key, value interface{}) bool {
@@ -740,7 +846,7 @@ func (c *DatabaseChanges) notifySubscribers(typ string, value interface{}) error
 		var indexChange *IndexChange
 		err := decodeJSONAsStruct(value, &indexChange)
 		if err != nil {
-			dcdbg("notifySubscribers: '%s' decodeJSONAsStruct failed with %s\n", typ, err)
+			c.logger().Error("changes: failed to decode notification", "type", typ, "error", err)
 			return err
 		}
 		fn := func(key, value interface{}) bool {
@@ -749,11 +855,24 @@ func (c *DatabaseChanges) notifySubscribers(typ string, value interface{}) error
 			return true
 		}
 		c.subscribers.Range(fn)
+	case "CounterChange":
+		var counterChange *CounterChange
+		err := decodeJSONAsStruct(value, &counterChange)
+		if err != nil {
+			c.logger().Error("changes: failed to decode notification", "type", typ, "error", err)
+			return err
+		}
+		fn := func(key, value interface{}) bool {
+			s := value.(*changeSubscribers)
+			s.sendCounterChange(counterChange)
+			return true
+		}
+		c.subscribers.Range(fn)
 	case "OperationStatusChange":
 		var operationStatusChange *OperationStatusChange
 		err := decodeJSONAsStruct(value, &operationStatusChange)
 		if err != nil {
-			dcdbg("notifySubscribers: '%s' decodeJSONAsStruct failed with %s\n", typ, err)
+			c.logger().Error("changes: failed to decode notification", "type", typ, "error", err)
 			return err
 		}
 		fn := func(key, value interface{}) bool {
@@ -763,7 +882,7 @@ func (c *DatabaseChanges) notifySubscribers(typ string, value interface{}) error
 		}
 		c.subscribers.Range(fn)
 	default:
-		dcdbg("DatabnaseChanges: notifySubscribers(): unsupported type '%s'\n", typ)
+		c.logger().Warn("changes: unsupported notification type", "type", typ)
 		return fmt.Errorf("notifySubscribers: unsupported type '%s'", typ)
 	}
 	return nil
@@ -796,10 +915,7 @@ func (c *DatabaseChanges) startProcessMessagesWorker(ctx context.Context, conn *
 			var msgArray []interface{} // an array of objects
 			err = conn.ReadJSON(&msgArray)
 			if err != nil {
-				if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-					dcdbg("DatabaseChanges: ReadJSON() failed with %s\n", err)
-				} else {
-					dcdbg("DatabaseChanges: ReadJSON() failed with %s, turning into no error\n", err)
+				if !websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 					err = nil
 				}
 				break
@@ -808,10 +924,7 @@ func (c *DatabaseChanges) startProcessMessagesWorker(ctx context.Context, conn *
 				continue
 			}
 
-			if enableDatabaseChangesDebugOutput {
-				s, _ := json.Marshal(msgArray)
-				dcdbg("DatatabaseChange: received messages:\n%s\n", s)
-			}
+			c.logger().Debug("changes: received messages", "count", len(msgArray))
 
 			for _, msgNodeV := range msgArray {
 				msgNode := msgNodeV.(map[string]interface{})
@@ -830,7 +943,7 @@ func (c *DatabaseChanges) startProcessMessagesWorker(ctx context.Context, conn *
 						if ok {
 							cmd := v.(*databaseChangesCommand)
 							cmd.confirm(false)
-							dcdbg("DatabaseChanges: confirmed command id %d, command '%s'\n", cmd.id, fmtDCCommand(cmd.command, cmd.value))
+							c.logger().Debug("changes: confirmed command", "id", cmd.id, "command", fmtDCCommand(cmd.command, cmd.value))
 						}
 					}
 				default:
@@ -842,7 +955,6 @@ func (c *DatabaseChanges) startProcessMessagesWorker(ctx context.Context, conn *
 			}
 		}
 		if err != nil {
-			dcdbg("Not cancelled so calling notifyAboutError(), err = %v\n", err)
 			c.notifyAboutError(err)
 		}
 		chFailed <- err
@@ -0,0 +1,26 @@
+package ravendb
+
+// DatabaseItemType describes a type of items in a database dump
+type DatabaseItemType = string
+
+const (
+	DatabaseItemTypeNone                       = "None"
+	DatabaseItemTypeDocuments                  = "Documents"
+	DatabaseItemTypeRevisionDocuments          = "RevisionDocuments"
+	DatabaseItemTypeIndexes                    = "Indexes"
+	DatabaseItemTypeIdentities                 = "Identities"
+	DatabaseItemTypeTombstones                 = "Tombstones"
+	DatabaseItemTypeLegacyAttachments          = "LegacyAttachments"
+	DatabaseItemTypeConflicts                  = "Conflicts"
+	DatabaseItemTypeCompareExchange            = "CompareExchange"
+	DatabaseItemTypeLegacyDocumentDeletions    = "LegacyDocumentDeletions"
+	DatabaseItemTypeLegacyAttachmentDeletions  = "LegacyAttachmentDeletions"
+	DatabaseItemTypeDatabaseRecord             = "DatabaseRecord"
+	DatabaseItemTypeUnknown                    = "Unknown"
+	DatabaseItemTypeAttachments                = "Attachments"
+	DatabaseItemTypeCounterGroups              = "CounterGroups"
+	DatabaseItemTypeSubscriptions              = "Subscriptions"
+	DatabaseItemTypeCompareExchangeTombstones  = "CompareExchangeTombstones"
+	DatabaseItemTypeTimeSeries                 = "TimeSeries"
+	DatabaseItemTypeReplicationHubCertificates = "ReplicationHubCertificates"
+)
@@ -0,0 +1,357 @@
+package ravendb
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"sync"
+)
+
+// DatabaseSmuggler exports and imports database dumps (.ravendbdump files),
+// which are gzip-compressed streams of database items.
+// Export and import are executed on the server as operations whose state
+// can be checked with GetOperationStateOperation
+type DatabaseSmuggler struct {
+	store        *DocumentStore
+	databaseName string
+}
+
+// NewDatabaseSmuggler returns a smuggler for a given database. If
+// databaseName is empty, the default database of the store is used
+func NewDatabaseSmuggler(store *DocumentStore, databaseName string) *DatabaseSmuggler {
+	if databaseName == "" {
+		databaseName = store.GetDatabase()
+	}
+	return &DatabaseSmuggler{
+		store:        store,
+		databaseName: databaseName,
+	}
+}
+
+// ForDatabase returns a smuggler for a different database on the same server
+func (s *DatabaseSmuggler) ForDatabase(databaseName string) *DatabaseSmuggler {
+	if databaseName == "" || databaseName == s.databaseName {
+		return s
+	}
+	return NewDatabaseSmuggler(s.store, databaseName)
+}
+
+func (s *DatabaseSmuggler) getRequestExecutor() (*RequestExecutor, error) {
+	if s.databaseName == "" {
+		return nil, newIllegalStateError("Cannot use smuggler without a database defined, did you forget to call ForDatabase?")
+	}
+	return s.store.GetRequestExecutor(s.databaseName), nil
+}
+
+func (s *DatabaseSmuggler) newOperation(requestExecutor *RequestExecutor, id int64, nodeTag string) *Operation {
+	changes := func() *DatabaseChanges {
+		return s.store.Changes(s.databaseName)
+	}
+	op := NewOperation(requestExecutor, changes, requestExecutor.GetConventions(), id)
+	op.NodeTag = nodeTag
+	return op
+}
+
+// Export writes a dump of the database to w. It returns after the whole
+// dump has been written
+func (s *DatabaseSmuggler) Export(options *DatabaseSmugglerExportOptions, w io.Writer) (*Operation, error) {
+	if options == nil {
+		return nil, newIllegalArgumentError("options cannot be null")
+	}
+	if w == nil {
+		return nil, newIllegalArgumentError("w cannot be null")
+	}
+	requestExecutor, err := s.getRequestExecutor()
+	if err != nil {
+		return nil, err
+	}
+	getOperationIDCommand := NewGetNextOperationIDCommand()
+	if err = requestExecutor.ExecuteCommand(getOperationIDCommand, nil); err != nil {
+		return nil, err
+	}
+	operationID := getOperationIDCommand.Result
+
+	command, err := NewSmugglerExportCommand(options, operationID, w)
+	if err != nil {
+		return nil, err
+	}
+	command.SelectedNodeTag = getOperationIDCommand.NodeTag
+	if err = requestExecutor.ExecuteCommand(command, nil); err != nil {
+		return nil, err
+	}
+	return s.newOperation(requestExecutor, operationID, getOperationIDCommand.NodeTag), nil
+}
+
+// ExportToFile writes a dump of the database to a file
+func (s *DatabaseSmuggler) ExportToFile(options *DatabaseSmugglerExportOptions, path string) (*Operation, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	op, err := s.Export(options, f)
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// ExportToDatabase copies items of this database to the database of
+// toDatabase, which can be on a different server. The dump is streamed
+// directly without being stored. Items are imported with the same options
+// except TransformScript and Collections, which are applied on export.
+// Returns the import operation
+func (s *DatabaseSmuggler) ExportToDatabase(options *DatabaseSmugglerExportOptions, toDatabase *DatabaseSmuggler) (*Operation, error) {
+	if options == nil {
+		return nil, newIllegalArgumentError("options cannot be null")
+	}
+	if toDatabase == nil {
+		return nil, newIllegalArgumentError("toDatabase cannot be null")
+	}
+	importOptions := &DatabaseSmugglerImportOptions{
+		DatabaseSmugglerOptions: options.DatabaseSmugglerOptions,
+	}
+	importOptions.TransformScript = ""
+	importOptions.Collections = nil
+
+	r, w := io.Pipe()
+	chExportErr := make(chan error, 1)
+	go func() {
+		_, err := s.Export(options, w)
+		// unblocks import if export failed
+		_ = w.CloseWithError(err)
+		chExportErr <- err
+	}()
+
+	op, err := toDatabase.Import(importOptions, r)
+	// unblocks export if import failed
+	_ = r.CloseWithError(io.ErrClosedPipe)
+	exportErr := <-chExportErr
+	if exportErr != nil && !errors.Is(exportErr, io.ErrClosedPipe) {
+		return nil, exportErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// Import imports a dump of a database read from r. It returns after the
+// whole dump has been sent to the server
+func (s *DatabaseSmuggler) Import(options *DatabaseSmugglerImportOptions, r io.Reader) (*Operation, error) {
+	if options == nil {
+		return nil, newIllegalArgumentError("options cannot be null")
+	}
+	if r == nil {
+		return nil, newIllegalArgumentError("r cannot be null")
+	}
+	requestExecutor, err := s.getRequestExecutor()
+	if err != nil {
+		return nil, err
+	}
+	getOperationIDCommand := NewGetNextOperationIDCommand()
+	if err = requestExecutor.ExecuteCommand(getOperationIDCommand, nil); err != nil {
+		return nil, err
+	}
+	operationID := getOperationIDCommand.Result
+
+	command, err := NewSmugglerImportCommand(options, r, operationID)
+	if err != nil {
+		return nil, err
+	}
+	command.SelectedNodeTag = getOperationIDCommand.NodeTag
+	if err = requestExecutor.ExecuteCommand(command, nil); err != nil {
+		return nil, err
+	}
+	return s.newOperation(requestExecutor, operationID, getOperationIDCommand.NodeTag), nil
+}
+
+// ImportFromFile imports a dump of a database from a file
+func (s *DatabaseSmuggler) ImportFromFile(options *DatabaseSmugglerImportOptions, path string) (*Operation, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return s.Import(options, f)
+}
+
+// sendWithoutTimeout sends a request that can take arbitrarily long
+// because it transfers a whole database
+func sendWithoutTimeout(client *http.Client, req *http.Request) (*http.Response, error) {
+	c := *client
+	c.Timeout = 0
+	return c.Do(req)
+}
+
+var (
+	_ RavenCommand = &SmugglerExportCommand{}
+)
+
+// SmugglerExportCommand exports a database dump and writes it to a writer
+type SmugglerExportCommand struct {
+	RavenCommandBase
+
+	options     map[string]interface{}
+	operationID int64
+	w           io.Writer
+	// set once the dump started to be written to w, after which the command
+	// can't be retried as it would append a second dump
+	written bool
+}
+
+// NewSmugglerExportCommand returns SmugglerExportCommand
+func NewSmugglerExportCommand(options *DatabaseSmugglerExportOptions, operationID int64, w io.Writer) (*SmugglerExportCommand, error) {
+	if options == nil {
+		return nil, newIllegalArgumentError("options cannot be null")
+	}
+	if w == nil {
+		return nil, newIllegalArgumentError("w cannot be null")
+	}
+	cmd := &SmugglerExportCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		options:     options.toJSON(),
+		operationID: operationID,
+		w:           w,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeRaw
+	return cmd, nil
+}
+
+func (c *SmugglerExportCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	if c.written {
+		return nil, newIllegalStateError("SmugglerExportCommand can't be retried because the dump was already partially written")
+	}
+	url := node.URL + "/databases/" + node.Database + "/smuggler/export?operationId=" + i64toa(c.operationID)
+
+	d, err := jsonMarshal(c.options)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+func (c *SmugglerExportCommand) Send(client *http.Client, req *http.Request) (*http.Response, error) {
+	return sendWithoutTimeout(client, req)
+}
+
+func (c *SmugglerExportCommand) SetResponseRaw(response *http.Response, body io.Reader) error {
+	if body == nil {
+		return nil
+	}
+	c.written = true
+	_, err := io.Copy(c.w, body)
+	return err
+}
+
+var (
+	_ RavenCommand = &SmugglerImportCommand{}
+)
+
+// SmugglerImportCommand imports a database dump read from a reader
+type SmugglerImportCommand struct {
+	RavenCommandBase
+
+	options     map[string]interface{}
+	r           io.Reader
+	operationID int64
+	// set once the dump started to be read from r, after which the command
+	// can't be retried as r was consumed. It's set by the goroutine writing
+	// request body
+	read atomicInteger
+}
+
+// NewSmugglerImportCommand returns SmugglerImportCommand
+func NewSmugglerImportCommand(options *DatabaseSmugglerImportOptions, r io.Reader, operationID int64) (*SmugglerImportCommand, error) {
+	if options == nil {
+		return nil, newIllegalArgumentError("options cannot be null")
+	}
+	if r == nil {
+		return nil, newIllegalArgumentError("r cannot be null")
+	}
+	cmd := &SmugglerImportCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		options:     options.toJSON(),
+		r:           r,
+		operationID: operationID,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd, nil
+}
+
+func (c *SmugglerImportCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	if c.read.get() > 0 {
+		return nil, newIllegalStateError("SmugglerImportCommand can't be retried because the dump was already partially read")
+	}
+	url := node.URL + "/databases/" + node.Database + "/smuggler/import?operationId=" + i64toa(c.operationID)
+
+	js, err := jsonMarshal(c.options)
+	if err != nil {
+		return nil, err
+	}
+
+	// the dump is streamed in a multipart form as it's being read. Nothing
+	// is read from r until the request is sent
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	body := &smugglerImportBody{
+		pr: pr,
+		write: func() {
+			c.read.incrementAndGet()
+			err := writer.WriteField("importOptions", string(js))
+			if err == nil {
+				h := make(textproto.MIMEHeader)
+				h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, "name"))
+				h.Set("Content-Type", "application/octet-stream")
+				var part io.Writer
+				part, err = writer.CreatePart(h)
+				if err == nil {
+					_, err = io.Copy(part, c.r)
+				}
+			}
+			if err == nil {
+				err = writer.Close()
+			}
+			_ = pw.CloseWithError(err)
+		},
+	}
+
+	request, err := newHttpPostReader(url, body)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	return request, nil
+}
+
+func (c *SmugglerImportCommand) Send(client *http.Client, req *http.Request) (*http.Response, error) {
+	return sendWithoutTimeout(client, req)
+}
+
+// smugglerImportBody is a body of import request. The goroutine writing
+// the multipart form starts on first Read, so that a request that is never
+// sent doesn't leak it. Closing the body stops the goroutine
+type smugglerImportBody struct {
+	pr    *io.PipeReader
+	write func()
+	once  sync.Once
+}
+
+func (b *smugglerImportBody) Read(p []byte) (int, error) {
+	b.once.Do(func() {
+		go b.write()
+	})
+	return b.pr.Read(p)
+}
+
+func (b *smugglerImportBody) Close() error {
+	return b.pr.Close()
+}
@@ -0,0 +1,101 @@
+package ravendb
+
+import "strings"
+
+const databaseSmugglerDefaultMaxStepsForTransformScript = 10 * 1000
+
+// DatabaseSmugglerDefaultOperateOnTypes are types of items exported and
+// imported by default
+var DatabaseSmugglerDefaultOperateOnTypes = []DatabaseItemType{
+	DatabaseItemTypeIndexes,
+	DatabaseItemTypeDocuments,
+	DatabaseItemTypeRevisionDocuments,
+	DatabaseItemTypeConflicts,
+	DatabaseItemTypeDatabaseRecord,
+	DatabaseItemTypeReplicationHubCertificates,
+	DatabaseItemTypeIdentities,
+	DatabaseItemTypeCompareExchange,
+	DatabaseItemTypeAttachments,
+	DatabaseItemTypeCounterGroups,
+	DatabaseItemTypeSubscriptions,
+	DatabaseItemTypeTimeSeries,
+}
+
+// DatabaseSmugglerOptions describes what is exported or imported by DatabaseSmuggler
+type DatabaseSmugglerOptions struct {
+	// types of items to export or import
+	OperateOnTypes    []DatabaseItemType
+	IncludeExpired    bool
+	IncludeArtificial bool
+	RemoveAnalyzers   bool
+	// JavaScript applied to every document, which can modify it or
+	// skip it by throwing 'skip'
+	TransformScript            string
+	MaxStepsForTransformScript int
+	SkipRevisionCreation       bool
+	EncryptionKey              string
+	// if not empty, only documents (and their attachments, counters,
+	// time series and revisions) from those collections are processed
+	Collections []string
+}
+
+func newDatabaseSmugglerOptions() DatabaseSmugglerOptions {
+	return DatabaseSmugglerOptions{
+		OperateOnTypes:             append([]DatabaseItemType(nil), DatabaseSmugglerDefaultOperateOnTypes...),
+		IncludeExpired:             true,
+		MaxStepsForTransformScript: databaseSmugglerDefaultMaxStepsForTransformScript,
+	}
+}
+
+// toJSON returns options in the format expected by the server. Types
+// are .NET flags which are serialized as comma-separated names
+func (o *DatabaseSmugglerOptions) toJSON() map[string]interface{} {
+	operateOnTypes := DatabaseItemTypeNone
+	if len(o.OperateOnTypes) > 0 {
+		operateOnTypes = strings.Join(o.OperateOnTypes, ", ")
+	}
+	res := map[string]interface{}{
+		"OperateOnTypes":             operateOnTypes,
+		"IncludeExpired":             o.IncludeExpired,
+		"IncludeArtificial":          o.IncludeArtificial,
+		"RemoveAnalyzers":            o.RemoveAnalyzers,
+		"MaxStepsForTransformScript": o.MaxStepsForTransformScript,
+		"SkipRevisionCreation":       o.SkipRevisionCreation,
+	}
+	if o.TransformScript != "" {
+		res["TransformScript"] = o.TransformScript
+	}
+	if o.EncryptionKey != "" {
+		res["EncryptionKey"] = o.EncryptionKey
+	}
+	if len(o.Collections) > 0 {
+		res["Collections"] = o.Collections
+	}
+	return res
+}
+
+// DatabaseSmugglerExportOptions describes what is exported by DatabaseSmuggler.Export()
+type DatabaseSmugglerExportOptions struct {
+	DatabaseSmugglerOptions
+}
+
+// NewDatabaseSmugglerExportOptions returns options for exporting all
+// items of DatabaseSmugglerDefaultOperateOnTypes
+func NewDatabaseSmugglerExportOptions() *DatabaseSmugglerExportOptions {
+	return &DatabaseSmugglerExportOptions{
+		DatabaseSmugglerOptions: newDatabaseSmugglerOptions(),
+	}
+}
+
+// DatabaseSmugglerImportOptions describes what is imported by DatabaseSmuggler.Import()
+type DatabaseSmugglerImportOptions struct {
+	DatabaseSmugglerOptions
+}
+
+// NewDatabaseSmugglerImportOptions returns options for importing all
+// items of DatabaseSmugglerDefaultOperateOnTypes
+func NewDatabaseSmugglerImportOptions() *DatabaseSmugglerImportOptions {
+	return &DatabaseSmugglerImportOptions{
+		DatabaseSmugglerOptions: newDatabaseSmugglerOptions(),
+	}
+}
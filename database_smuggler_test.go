@@ -0,0 +1,93 @@
+package ravendb
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmugglerCommands(t *testing.T) {
+	dump := []byte("\x1f\x8b fake dump content")
+	var exportOptions map[string]interface{}
+	var importOptions map[string]interface{}
+	var imported []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/databases/db/smuggler/export":
+			assert.Equal(t, "5", r.URL.Query().Get("operationId"))
+			d, _ := ioutil.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(d, &exportOptions))
+			_, _ = w.Write(dump)
+		case "/databases/db/smuggler/import":
+			assert.Equal(t, "6", r.URL.Query().Get("operationId"))
+			assert.NoError(t, r.ParseMultipartForm(1024*1024))
+			assert.NoError(t, json.Unmarshal([]byte(r.FormValue("importOptions")), &importOptions))
+			f, _, err := r.FormFile("file")
+			if assert.NoError(t, err) {
+				imported, _ = ioutil.ReadAll(f)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(srv.URL, "db", nil, nil, nil)
+	defer re.Close()
+
+	options := NewDatabaseSmugglerExportOptions()
+	options.OperateOnTypes = []DatabaseItemType{DatabaseItemTypeDocuments, DatabaseItemTypeIndexes}
+	options.Collections = []string{"Users"}
+	var buf bytes.Buffer
+	exportCmd, err := NewSmugglerExportCommand(options, 5, &buf)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(exportCmd, nil))
+	assert.Equal(t, dump, buf.Bytes())
+	assert.Equal(t, "Documents, Indexes", exportOptions["OperateOnTypes"])
+	assert.Equal(t, []interface{}{"Users"}, exportOptions["Collections"])
+	assert.Equal(t, true, exportOptions["IncludeExpired"])
+	assert.Equal(t, float64(10000), exportOptions["MaxStepsForTransformScript"])
+
+	importCmd, err := NewSmugglerImportCommand(NewDatabaseSmugglerImportOptions(), bytes.NewReader(dump), 6)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(importCmd, nil))
+	assert.Equal(t, dump, imported)
+	assert.Contains(t, importOptions["OperateOnTypes"], "Documents")
+	assert.Nil(t, importOptions["Collections"])
+}
+
+func TestSmugglerCommandsAreNotRetriedAfterStreamingStarted(t *testing.T) {
+	node := &ServerNode{URL: "http://localhost:8080", Database: "db"}
+
+	importCmd, err := NewSmugglerImportCommand(NewDatabaseSmugglerImportOptions(), bytes.NewReader([]byte("dump")), 6)
+	require.NoError(t, err)
+	// a request that wasn't sent doesn't consume the dump
+	req, err := importCmd.CreateRequest(node)
+	require.NoError(t, err)
+	require.NoError(t, req.Body.Close())
+	req, err = importCmd.CreateRequest(node)
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(req.Body)
+	require.NoError(t, err)
+	_, err = importCmd.CreateRequest(node)
+	_, ok := err.(*IllegalStateError)
+	assert.True(t, ok, "expected IllegalStateError, got %v", err)
+
+	var buf bytes.Buffer
+	exportCmd, err := NewSmugglerExportCommand(NewDatabaseSmugglerExportOptions(), 5, &buf)
+	require.NoError(t, err)
+	_, err = exportCmd.CreateRequest(node)
+	require.NoError(t, err)
+	require.NoError(t, exportCmd.SetResponseRaw(nil, bytes.NewReader([]byte("partial"))))
+	_, err = exportCmd.CreateRequest(node)
+	_, ok = err.(*IllegalStateError)
+	assert.True(t, ok, "expected IllegalStateError, got %v", err)
+	assert.Equal(t, "partial", buf.String())
+}
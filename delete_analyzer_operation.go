@@ -0,0 +1,62 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var _ IVoidMaintenanceOperation = &DeleteAnalyzerOperation{}
+
+// DeleteAnalyzerOperation deletes a custom analyzer from the database
+type DeleteAnalyzerOperation struct {
+	analyzerName string
+
+	Command *DeleteAnalyzerCommand
+}
+
+// NewDeleteAnalyzerOperation returns an operation that deletes an analyzer
+func NewDeleteAnalyzerOperation(analyzerName string) (*DeleteAnalyzerOperation, error) {
+	if analyzerName == "" {
+		return nil, newIllegalArgumentError("analyzerName cannot be empty")
+	}
+	return &DeleteAnalyzerOperation{
+		analyzerName: analyzerName,
+	}, nil
+}
+
+func (o *DeleteAnalyzerOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewDeleteAnalyzerCommand(o.analyzerName)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
+
+var (
+	_ RavenCommand = &DeleteAnalyzerCommand{}
+)
+
+type DeleteAnalyzerCommand struct {
+	RavenCommandBase
+
+	analyzerName string
+}
+
+func NewDeleteAnalyzerCommand(analyzerName string) (*DeleteAnalyzerCommand, error) {
+	if analyzerName == "" {
+		return nil, newIllegalArgumentError("analyzerName cannot be empty")
+	}
+	cmd := &DeleteAnalyzerCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		analyzerName: analyzerName,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd, nil
+}
+
+func (c *DeleteAnalyzerCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/analyzers?name=" + urlUtilsEscapeDataString(c.analyzerName)
+
+	return newHttpDelete(url, nil)
+}
@@ -0,0 +1,62 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var _ IServerOperation = &DeleteServerWideAnalyzerOperation{}
+
+// DeleteServerWideAnalyzerOperation deletes a server-wide custom analyzer
+type DeleteServerWideAnalyzerOperation struct {
+	analyzerName string
+
+	Command *DeleteServerWideAnalyzerCommand
+}
+
+// NewDeleteServerWideAnalyzerOperation returns an operation that deletes an analyzer
+func NewDeleteServerWideAnalyzerOperation(analyzerName string) (*DeleteServerWideAnalyzerOperation, error) {
+	if analyzerName == "" {
+		return nil, newIllegalArgumentError("analyzerName cannot be empty")
+	}
+	return &DeleteServerWideAnalyzerOperation{
+		analyzerName: analyzerName,
+	}, nil
+}
+
+func (o *DeleteServerWideAnalyzerOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewDeleteServerWideAnalyzerCommand(o.analyzerName)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
+
+var (
+	_ RavenCommand = &DeleteServerWideAnalyzerCommand{}
+)
+
+type DeleteServerWideAnalyzerCommand struct {
+	RavenCommandBase
+
+	analyzerName string
+}
+
+func NewDeleteServerWideAnalyzerCommand(analyzerName string) (*DeleteServerWideAnalyzerCommand, error) {
+	if analyzerName == "" {
+		return nil, newIllegalArgumentError("analyzerName cannot be empty")
+	}
+	cmd := &DeleteServerWideAnalyzerCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		analyzerName: analyzerName,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd, nil
+}
+
+func (c *DeleteServerWideAnalyzerCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/admin/analyzers?name=" + urlUtilsEscapeDataString(c.analyzerName)
+
+	return newHttpDelete(url, nil)
+}
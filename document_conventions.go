@@ -46,6 +46,32 @@ type DocumentConventions struct {
 
 	maxHttpCacheSize int
 
+	// Compression selects compression of bodies of bulk insert, batch
+	// (SaveChanges) and put document requests. Responses are always
+	// accepted gzip-compressed and transparently decompressed
+	Compression CompressionAlgorithm
+
+	// Logger receives events like failovers, topology updates and reconnects
+	// of changes and subscriptions. *slog.Logger can be used directly.
+	// Must be set before DocumentStore is initialized
+	Logger Logger
+
+	// RequestHook is notified about every request sent to the server.
+	// Must be set before DocumentStore is initialized
+	RequestHook RequestHook
+
+	// HTTPRecorder records requests sent to the server and responses to them.
+	// Must be set before DocumentStore is initialized
+	HTTPRecorder *HTTPRecorder
+
+	// HTTPReplayer serves responses recorded by HTTPRecorder instead of
+	// sending requests to the server. Takes precedence over HTTPRecorder.
+	// Must be set before DocumentStore is initialized
+	HTTPReplayer *HTTPReplayer
+
+	// set by DocumentStore
+	metrics *Metrics
+
 	// a pointer to silence go vet when copying DocumentConventions wholesale
 	mu *sync.Mutex
 }
@@ -69,6 +95,7 @@ func NewDocumentConventions() *DocumentConventions {
 		transformClassCollectionNameToDocumentIDPrefix: getDefaultTransformCollectionNameToDocumentIdPrefix,
 		MaxNumberOfRequestsPerSession:                  32,
 		maxHttpCacheSize:                               128 * 1024 * 1024,
+		Compression:                                    CompressionAlgorithmNone,
 		mu:                                             &sync.Mutex{},
 	}
 }
@@ -77,10 +104,37 @@ func (c *DocumentConventions) getMaxHttpCacheSize() int {
 	return c.maxHttpCacheSize
 }
 
+// SetMaxHttpCacheSize sets maximum size, in bytes, of cached responses
+// kept by each RequestExecutor. When the limit is reached, least recently
+// used responses are evicted. Responses bigger than the cache are not
+// cached. 0 means a default size of 1 MB.
+// Must be set before DocumentStore is initialized
+func (c *DocumentConventions) SetMaxHttpCacheSize(sizeInBytes int) error {
+	if err := c.assertNotFrozen(); err != nil {
+		return err
+	}
+	if sizeInBytes < 0 {
+		return newIllegalArgumentError("sizeInBytes must be >= 0, is %d", sizeInBytes)
+	}
+	c.maxHttpCacheSize = sizeInBytes
+	return nil
+}
+
+func (c *DocumentConventions) isCompressionEnabled() bool {
+	return c.Compression == CompressionAlgorithmGzip
+}
+
 func (c *DocumentConventions) Freeze() {
 	c.frozen = true
 }
 
+func (c *DocumentConventions) assertNotFrozen() error {
+	if c.frozen {
+		return newIllegalStateError("Conventions has been frozen after documentStore.Initialize() and no changes can be applied to them")
+	}
+	return nil
+}
+
 // GetCollectionNameDefault is a default way of
 func GetCollectionNameDefault(entityOrType interface{}) string {
 	name := getShortTypeNameForEntityOrType(entityOrType)
@@ -102,6 +102,33 @@ func (q *DocumentQuery) SelectFieldsWithQueryData(projectionType reflect.Type, q
 	return res
 }
 
+// SelectJavaScript returns values computed on the server with JavaScript
+// projection. Results are deserialized into projectionType
+func (q *DocumentQuery) SelectJavaScript(projectionType reflect.Type, projection *JavaScriptProjection) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	if projectionType == nil {
+		q.err = newIllegalArgumentError("projectionType cannot be nil")
+		return q
+	}
+	if projection == nil {
+		q.err = newIllegalArgumentError("projection cannot be nil")
+		return q
+	}
+	queryData, err := projection.toQueryData()
+	if err != nil {
+		q.err = err
+		return q
+	}
+	res, err := q.createDocumentQueryInternal(projectionType, queryData)
+	if err != nil {
+		q.err = err
+		return q
+	}
+	return res
+}
+
 // Distinct marks query as distinct
 func (q *DocumentQuery) Distinct() *DocumentQuery {
 	if q.err != nil {
@@ -130,7 +157,22 @@ func (q *DocumentQuery) OrderByScoreDescending() *DocumentQuery {
 	return q
 }
 
-//TBD 4.1  IDocumentQuery<T> explainScores() {
+// ExplainScores requests explanations of how scores of results were
+// calculated. After the query is executed, explanations contains them,
+// keyed by document id
+func (q *DocumentQuery) ExplainScores(explanations **Explanations) *DocumentQuery {
+	return q.ExplainScoresWithOptions(nil, explanations)
+}
+
+// ExplainScoresWithOptions is like ExplainScores but allows keying
+// explanations by a given field
+func (q *DocumentQuery) ExplainScoresWithOptions(options *ExplanationOptions, explanations **Explanations) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.includeExplanations(options, explanations)
+	return q
+}
 
 // WaitForNonStaleResults waits for non-stale results for a given waitTimeout.
 // Timeout of 0 means default timeout.
@@ -281,7 +323,15 @@ func (q *DocumentQuery) NoCaching() *DocumentQuery {
 	return q
 }
 
-//TBD 4.1  IDocumentQuery<T> showTimings()
+// Timings requests information about time spent by the server in each
+// stage of the query. After the query is executed, timings contains it
+func (q *DocumentQuery) Timings(timings **QueryTimings) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.includeTimings(timings)
+	return q
+}
 
 func (q *DocumentQuery) Include(path string) *DocumentQuery {
 	q.include(path)
@@ -290,6 +340,34 @@ func (q *DocumentQuery) Include(path string) *DocumentQuery {
 
 //TBD expr IDocumentQuery<T> IDocumentQueryBase<T, IDocumentQuery<T>>.Include(Expression<Func<T, object>> path)
 
+// IncludeCounters includes given counters of documents returned by the query
+func (q *DocumentQuery) IncludeCounters(counters ...string) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.includeCounters(counters)
+	return q
+}
+
+// IncludeTimeSeries includes entries of a given time series between from and to
+// of documents returned by the query. nil from or to means the range is unbounded
+func (q *DocumentQuery) IncludeTimeSeries(name string, from *time.Time, to *time.Time) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.includeTimeSeries(name, from, to)
+	return q
+}
+
+// IncludeAllCounters includes all counters of documents returned by the query
+func (q *DocumentQuery) IncludeAllCounters() *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.includeAllCountersInQuery()
+	return q
+}
+
 func (q *DocumentQuery) Not() *DocumentQuery {
 	q.negateNext()
 	return q
@@ -536,7 +614,15 @@ func (q *DocumentQuery) RandomOrderingWithSeed(seed string) *DocumentQuery {
 	return q
 }
 
-//TBD 4.1  IDocumentQuery<T> customSortUsing(string typeName, bool descending)
+// CustomSortUsing orders query results using a custom sorter uploaded
+// with PutSortersOperation
+func (q *DocumentQuery) CustomSortUsing(sorterName string, descending bool) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.customSortUsing(sorterName, descending)
+	return q
+}
 
 // GroupBy makes a query grouped by fields
 func (q *DocumentQuery) GroupBy(fieldName string, fieldNames ...string) *GroupByDocumentQuery {
@@ -576,6 +662,16 @@ func (q *DocumentQuery) OrderByWithOrdering(field string, ordering OrderingType)
 
 //TBD expr  IDocumentQuery<T> OrderBy<TValue>(params Expression<Func<T, TValue>>[] propertySelectors)
 
+// OrderByWithSorter orders query results by a field using a custom sorter
+// uploaded with PutSortersOperation
+func (q *DocumentQuery) OrderByWithSorter(field string, sorterName string) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.orderByWithSorter(field, sorterName, false)
+	return q
+}
+
 // OrderByDescending orders query by a field in descending order
 func (q *DocumentQuery) OrderByDescending(field string) *DocumentQuery {
 	return q.OrderByDescendingWithOrdering(field, OrderingTypeString)
@@ -592,6 +688,16 @@ func (q *DocumentQuery) OrderByDescendingWithOrdering(field string, ordering Ord
 
 //TBD expr  IDocumentQuery<T> OrderByDescending<TValue>(params Expression<Func<T, TValue>>[] propertySelectors)
 
+// OrderByDescendingWithSorter orders query results by a field in descending
+// order using a custom sorter uploaded with PutSortersOperation
+func (q *DocumentQuery) OrderByDescendingWithSorter(field string, sorterName string) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.orderByWithSorter(field, sorterName, true)
+	return q
+}
+
 // AddBeforeQueryExecutedListener adds a listener that will be called before query
 // is executed
 func (q *DocumentQuery) AddBeforeQueryExecutedListener(action func(*IndexQuery)) int {
@@ -672,6 +778,14 @@ func (q *abstractDocumentQuery) createDocumentQueryInternal(resultClass reflect.
 	query.negate = q.negate
 	//noinspection unchecked
 	query.includes = stringArrayCopy(q.includes)
+	query.counterIncludes = stringArrayCopy(q.counterIncludes)
+	query.includeAllCounters = q.includeAllCounters
+	query.timeSeriesIncludes = append([]*TimeSeriesRange(nil), q.timeSeriesIncludes...)
+	query.highlightingTokens = q.highlightingTokens
+	query.queryHighlightings = q.queryHighlightings
+	query.explanationToken = q.explanationToken
+	query.explanations = q.explanations
+	query.queryTimings = q.queryTimings
 	// TODO: should this be deep copy so that adding/removing in one
 	// doesn't affect the other?
 	query.beforeQueryExecutedCallback = q.beforeQueryExecutedCallback
@@ -679,8 +793,6 @@ func (q *abstractDocumentQuery) createDocumentQueryInternal(resultClass reflect.
 	query.afterStreamExecutedCallback = q.afterStreamExecutedCallback
 	query.disableEntitiesTracking = q.disableEntitiesTracking
 	query.disableCaching = q.disableCaching
-	//TBD 4.1 ShowQueryTimings = ShowQueryTimings,
-	//TBD 4.1 query.shouldExplainScores = shouldExplainScores;
 	query.isIntersect = q.isIntersect
 	query.defaultOperator = q.defaultOperator
 
@@ -723,14 +835,23 @@ func (q *DocumentQuery) AggregateUsing(facetSetupDocumentID string) *Aggregation
 	return res
 }
 
-//TBD 4.1 IDocumentQuery<T> IDocumentQueryBase<T, IDocumentQuery<T>>.Highlight(string fieldName, int fragmentLength, int fragmentCount, string fragmentsField)
-//TBD 4.1 IDocumentQuery<T> IDocumentQueryBase<T, IDocumentQuery<T>>.Highlight(string fieldName, int fragmentLength, int fragmentCount, out FieldHighlightings highlightings)
-//TBD 4.1 IDocumentQuery<T> IDocumentQueryBase<T, IDocumentQuery<T>>.Highlight(string fieldName,string fieldKeyName, int fragmentLength,int fragmentCount,out FieldHighlightings highlightings)
-//TBD 4.1 IDocumentQuery<T> IDocumentQueryBase<T, IDocumentQuery<T>>.Highlight<TValue>(Expression<Func<T, TValue>> propertySelector, int fragmentLength, int fragmentCount, Expression<Func<T, IEnumerable>> fragmentsPropertySelector)
-//TBD 4.1 IDocumentQuery<T> IDocumentQueryBase<T, IDocumentQuery<T>>.Highlight<TValue>(Expression<Func<T, TValue>> propertySelector, int fragmentLength, int fragmentCount, out FieldHighlightings fieldHighlightings)
-//TBD 4.1 IDocumentQuery<T> IDocumentQueryBase<T, IDocumentQuery<T>>.Highlight<TValue>(Expression<Func<T, TValue>> propertySelector, Expression<Func<T, TValue>> keyPropertySelector, int fragmentLength, int fragmentCount, out FieldHighlightings fieldHighlightings)
-//TBD 4.1 IDocumentQuery<T> IDocumentQueryBase<T, IDocumentQuery<T>>.SetHighlighterTags(string preTag, string postTag)
-//TBD 4.1 IDocumentQuery<T> IDocumentQueryBase<T, IDocumentQuery<T>>.SetHighlighterTags(string[] preTags, string[] postTags)
+// Highlight requests highlighting of search terms in a given field.
+// After the query is executed, highlightings contains highlighted
+// fragments of the field, keyed by document id
+func (q *DocumentQuery) Highlight(fieldName string, fragmentLength int, fragmentCount int, highlightings **Highlightings) *DocumentQuery {
+	return q.HighlightWithOptions(fieldName, fragmentLength, fragmentCount, nil, highlightings)
+}
+
+// HighlightWithOptions is like Highlight but allows customizing
+// highlighting tags and a field used to key results
+func (q *DocumentQuery) HighlightWithOptions(fieldName string, fragmentLength int, fragmentCount int, options *HighlightingOptions, highlightings **Highlightings) *DocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.highlight(fieldName, fragmentLength, fragmentCount, options, highlightings)
+	return q
+}
+
 //TBD expr  IDocumentQuery<T> Spatial(Expression<Func<T, object>> path, Func<SpatialCriteriaFactory, SpatialCriteria> clause)
 
 func (q *DocumentQuery) Spatial3(fieldName string, clause func(*SpatialCriteriaFactory) SpatialCriteria) *DocumentQuery {
@@ -1,6 +1,7 @@
 package ravendb
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -86,6 +87,12 @@ func newDocumentSessionBase(dbName string, documentStore *DocumentStore, id stri
 
 // SaveChanges saves changes queued in memory to the database
 func (s *DocumentSession) SaveChanges() error {
+	return s.SaveChangesCtx(context.Background())
+}
+
+// SaveChangesCtx is like SaveChanges but the request to the server
+// is cancelled when ctx is done
+func (s *DocumentSession) SaveChangesCtx(ctx context.Context) error {
 	saveChangeOperation := newBatchOperation(s.InMemoryDocumentSessionOperations)
 
 	command, err := saveChangeOperation.createRequest()
@@ -98,7 +105,7 @@ func (s *DocumentSession) SaveChanges() error {
 	defer func() {
 		_ = command.Close()
 	}()
-	err = s.requestExecutor.ExecuteCommand(command, s.sessionInfo)
+	err = s.requestExecutor.ExecuteCommandCtx(ctx, command, s.sessionInfo)
 	if err != nil {
 		return err
 	}
@@ -108,6 +115,12 @@ func (s *DocumentSession) SaveChanges() error {
 
 // Exists returns true if an entity with a given id exists in the database
 func (s *DocumentSession) Exists(id string) (bool, error) {
+	return s.ExistsCtx(context.Background(), id)
+}
+
+// ExistsCtx is like Exists but the request to the server is cancelled
+// when ctx is done
+func (s *DocumentSession) ExistsCtx(ctx context.Context, id string) (bool, error) {
 	if id == "" {
 		return false, newIllegalArgumentError("id cannot be empty string")
 	}
@@ -121,7 +134,7 @@ func (s *DocumentSession) Exists(id string) (bool, error) {
 	}
 	command := NewHeadDocumentCommand(id, nil)
 
-	if err := s.requestExecutor.ExecuteCommand(command, s.sessionInfo); err != nil {
+	if err := s.requestExecutor.ExecuteCommandCtx(ctx, command, s.sessionInfo); err != nil {
 		return false, err
 	}
 
@@ -248,6 +261,22 @@ func (s *DocumentSession) Include(path string) *MultiLoaderWithInclude {
 	return NewMultiLoaderWithInclude(s).Include(path)
 }
 
+// IncludeCounters starts a load that also includes given counters of loaded documents
+func (s *DocumentSession) IncludeCounters(counters ...string) *MultiLoaderWithInclude {
+	return NewMultiLoaderWithInclude(s).IncludeCounters(counters...)
+}
+
+// IncludeAllCounters starts a load that also includes all counters of loaded documents
+func (s *DocumentSession) IncludeAllCounters() *MultiLoaderWithInclude {
+	return NewMultiLoaderWithInclude(s).IncludeAllCounters()
+}
+
+// IncludeTimeSeries starts a load that also includes entries of a given time series
+// between from and to. nil from or to means the range is unbounded
+func (s *DocumentSession) IncludeTimeSeries(name string, from *time.Time, to *time.Time) *MultiLoaderWithInclude {
+	return NewMultiLoaderWithInclude(s).IncludeTimeSeries(name, from, to)
+}
+
 func (s *DocumentSession) addLazyOperation(operation ILazyOperation, onEval func(), onEvalResult interface{}) *Lazy {
 	s.pendingLazyOperations = append(s.pendingLazyOperations, operation)
 
@@ -337,6 +366,12 @@ func checkValidLoadArg(v interface{}, argName string) error {
 // Load loads an entity with a given id and sets result to it.
 // result should be of type **<struct> or *map[string]interface{}
 func (s *DocumentSession) Load(result interface{}, id string) error {
+	return s.LoadCtx(context.Background(), result, id)
+}
+
+// LoadCtx is like Load but the request to the server is cancelled
+// when ctx is done
+func (s *DocumentSession) LoadCtx(ctx context.Context, result interface{}, id string) error {
 	if id == "" {
 		return newIllegalArgumentError("id cannot be empty string")
 	}
@@ -353,7 +388,7 @@ func (s *DocumentSession) Load(result interface{}, id string) error {
 	}
 
 	if command != nil {
-		err := s.requestExecutor.ExecuteCommand(command, s.sessionInfo)
+		err := s.requestExecutor.ExecuteCommandCtx(ctx, command, s.sessionInfo)
 		if err != nil {
 			return err
 		}
@@ -396,6 +431,12 @@ func checkValidLoadMultiArg(v interface{}, argName string) error {
 // LoadMulti loads multiple values with given ids into results, which should
 // be a map from string (id) to pointer to struct
 func (s *DocumentSession) LoadMulti(results interface{}, ids []string) error {
+	return s.LoadMultiCtx(context.Background(), results, ids)
+}
+
+// LoadMultiCtx is like LoadMulti but the request to the server is cancelled
+// when ctx is done
+func (s *DocumentSession) LoadMultiCtx(ctx context.Context, results interface{}, ids []string) error {
 	if len(ids) == 0 {
 		return newIllegalArgumentError("ids cannot be empty array")
 	}
@@ -403,14 +444,14 @@ func (s *DocumentSession) LoadMulti(results interface{}, ids []string) error {
 		return err
 	}
 	loadOperation := NewLoadOperation(s.InMemoryDocumentSessionOperations)
-	err := s.loadInternalWithOperation(ids, loadOperation, nil)
+	err := s.loadInternalWithOperation(ctx, ids, loadOperation, nil)
 	if err != nil {
 		return err
 	}
 	return loadOperation.getDocuments(results)
 }
 
-func (s *DocumentSession) loadInternalWithOperation(ids []string, operation *LoadOperation, stream io.Writer) error {
+func (s *DocumentSession) loadInternalWithOperation(ctx context.Context, ids []string, operation *LoadOperation, stream io.Writer) error {
 	operation.byIds(ids)
 
 	command, err := operation.createRequest()
@@ -418,7 +459,7 @@ func (s *DocumentSession) loadInternalWithOperation(ids []string, operation *Loa
 		return err
 	}
 	if command != nil {
-		err := s.requestExecutor.ExecuteCommand(command, s.sessionInfo)
+		err := s.requestExecutor.ExecuteCommandCtx(ctx, command, s.sessionInfo)
 		if err != nil {
 			return err
 		}
@@ -437,6 +478,10 @@ func (s *DocumentSession) loadInternalWithOperation(ids []string, operation *Loa
 
 // results should be map[string]*struct
 func (s *DocumentSession) loadInternalMulti(results interface{}, ids []string, includes []string) error {
+	return s.loadInternalMultiFull(results, ids, includes, nil, false, nil)
+}
+
+func (s *DocumentSession) loadInternalMultiFull(results interface{}, ids []string, includes []string, counterIncludes []string, includeAllCounters bool, timeSeriesIncludes []*TimeSeriesRange) error {
 	if len(ids) == 0 {
 		return newIllegalArgumentError("ids cannot be empty array")
 	}
@@ -444,6 +489,8 @@ func (s *DocumentSession) loadInternalMulti(results interface{}, ids []string, i
 	loadOperation := NewLoadOperation(s.InMemoryDocumentSessionOperations)
 	loadOperation.byIds(ids)
 	loadOperation.withIncludes(includes)
+	loadOperation.withCounters(counterIncludes, includeAllCounters)
+	loadOperation.withTimeSeries(timeSeriesIncludes)
 
 	command, err := loadOperation.createRequest()
 	if err != nil {
@@ -523,7 +570,7 @@ func (s *DocumentSession) LoadIntoStream(ids []string, output io.Writer) error {
 	}
 
 	op := NewLoadOperation(s.InMemoryDocumentSessionOperations)
-	return s.loadInternalWithOperation(ids, op, output)
+	return s.loadInternalWithOperation(context.Background(), ids, op, output)
 }
 
 // Increment increments member identified by path in an entity by a given
@@ -1,6 +1,7 @@
 package ravendb
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"strings"
@@ -47,12 +48,15 @@ type DocumentStore struct {
 	multiDbHiLo                  *MultiDatabaseHiLoIDGenerator
 	maintenanceOperationExecutor *MaintenanceOperationExecutor
 	operationExecutor            *OperationExecutor
+	smuggler                     *DatabaseSmuggler
 	identifier                   string
 	aggressiveCachingUsed        bool
 
 	afterClose  []func(*DocumentStore)
 	beforeClose []func(*DocumentStore)
 
+	metrics *Metrics
+
 	mu sync.Mutex
 }
 
@@ -228,9 +232,35 @@ func newDocumentStore() *DocumentStore {
 		aggressiveCacheChanges: map[string]*evictItemsFromCacheBasedOnChanges{},
 	}
 	s.subscriptions = newDocumentSubscriptions(s)
+	s.metrics = newMetrics(s.getHttpCacheStats)
 	return s
 }
 
+// Metrics returns statistics about requests, failovers, http cache,
+// changes and subscriptions of this store
+func (s *DocumentStore) Metrics() *Metrics {
+	return s.metrics
+}
+
+// getHttpCacheStats returns combined stats of http caches of all request executors.
+// Caches of closed executors are included so that the counters never go down
+func (s *DocumentStore) getHttpCacheStats() *HttpCacheStats {
+	res := &HttpCacheStats{}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, re := range s.requestsExecutors {
+		stats := re.Cache.GetStats()
+		res.NumberOfItems += stats.NumberOfItems
+		res.SizeInBytes += stats.SizeInBytes
+		res.MaxSizeInBytes += stats.MaxSizeInBytes
+		res.Hits += stats.Hits
+		res.Misses += stats.Misses
+		res.NotModified += stats.NotModified
+		res.Evictions += stats.Evictions
+	}
+	return res
+}
+
 func NewDocumentStore(urls []string, database string) *DocumentStore {
 	res := newDocumentStore()
 	if len(urls) > 0 {
@@ -266,10 +296,9 @@ func (s *DocumentStore) SetIdentifier(identifier string) {
 // Close closes the Store
 func (s *DocumentStore) Close() {
 	if s.disposed {
-		redbg("DocumentStore.Close: already disposed\n")
 		return
 	}
-	redbg("DocumentStore.Close\n")
+	s.GetConventions().getLogger().Debug("closing document store", "database", s.database)
 
 	for _, fn := range s.beforeClose {
 		fn(s)
@@ -345,14 +374,18 @@ func (s *DocumentStore) OpenSessionWithOptions(options *SessionOptions) (*Docume
 	return session, nil
 }
 
-func (s *DocumentStore) ExecuteIndex(task *IndexCreationTask, database string) error {
+// ExecuteIndex creates the index defined by task (IndexCreationTask or
+// JavaScriptIndexCreationTask) in a given database
+func (s *DocumentStore) ExecuteIndex(task IAbstractIndexCreationTask, database string) error {
 	if err := s.assertInitialized(); err != nil {
 		return err
 	}
 	return task.Execute(s, s.conventions, database)
 }
 
-func (s *DocumentStore) ExecuteIndexes(tasks []*IndexCreationTask, database string) error {
+// ExecuteIndexes creates indexes defined by tasks (IndexCreationTask or
+// JavaScriptIndexCreationTask) in a given database
+func (s *DocumentStore) ExecuteIndexes(tasks []IAbstractIndexCreationTask, database string) error {
 	if err := s.assertInitialized(); err != nil {
 		return err
 	}
@@ -365,6 +398,25 @@ func (s *DocumentStore) ExecuteIndexes(tasks []*IndexCreationTask, database stri
 	return s.Maintenance().ForDatabase(database).Send(op)
 }
 
+// DeployIndexes deploys only indexes whose definitions differ from
+// definitions on the server and returns a list of changes.
+// options are optional
+func (s *DocumentStore) DeployIndexes(tasks []IAbstractIndexCreationTask, database string, options *IndexDeploymentOptions) (*IndexDeploymentResult, error) {
+	return s.DeployIndexesCtx(context.Background(), tasks, database, options)
+}
+
+// DeployIndexesCtx is like DeployIndexes but stops when ctx is done and
+// returns ctx.Err(). Indexes already sent to the server stay deployed
+func (s *DocumentStore) DeployIndexesCtx(ctx context.Context, tasks []IAbstractIndexCreationTask, database string, options *IndexDeploymentOptions) (*IndexDeploymentResult, error) {
+	if err := s.assertInitialized(); err != nil {
+		return nil, err
+	}
+	if database == "" {
+		database = s.GetDatabase()
+	}
+	return deployIndexes(ctx, s, tasks, database, options)
+}
+
 // GetRequestExecutor gets a request executor.
 // database is optional
 func (s *DocumentStore) GetRequestExecutor(database string) *RequestExecutor {
@@ -415,6 +467,9 @@ func (s *DocumentStore) Initialize() error {
 		}
 		conventions.SetDocumentIDGenerator(genID)
 	}
+	// shared by request executors, which use a copy of conventions
+	conventions.metrics = s.metrics
+	conventions.Freeze()
 	s.initialized = true
 	return nil
 }
@@ -591,6 +646,16 @@ func (s *DocumentStore) Operations() *OperationExecutor {
 	return s.operationExecutor
 }
 
+// Smuggler returns DatabaseSmuggler for exporting and importing
+// the default database of the store
+func (s *DocumentStore) Smuggler() *DatabaseSmuggler {
+	if s.smuggler == nil {
+		s.smuggler = NewDatabaseSmuggler(s, "")
+	}
+
+	return s.smuggler
+}
+
 func (s *DocumentStore) BulkInsert(database string) *BulkInsertOperation {
 	if database == "" {
 		database = s.GetDatabase()
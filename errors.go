@@ -122,6 +122,18 @@ func newAllTopologyNodesDownError(format string, args ...interface{}) *AllTopolo
 	return res
 }
 
+// RequestedNodeUnavailableError represents an error when a command must be
+// executed on a node that is not available
+type RequestedNodeUnavailableError struct {
+	errorBase
+}
+
+func newRequestedNodeUnavailableError(format string, args ...interface{}) *RequestedNodeUnavailableError {
+	res := &RequestedNodeUnavailableError{}
+	res.setErrorf(format, args...)
+	return res
+}
+
 // OperationCancelledError represents "operation cancelled" error
 type OperationCancelledError struct {
 	errorBase
@@ -234,6 +246,22 @@ func newIndexDoesNotExistError(format string, args ...interface{}) *IndexDoesNot
 	return res
 }
 
+// IndexFailedError is returned when waiting for indexes that are faulty
+// or in error state. IndexErrors are errors reported by those indexes
+type IndexFailedError struct {
+	RavenError
+
+	IndexErrors []*IndexErrors
+}
+
+func newIndexFailedError(indexErrors []*IndexErrors, format string, args ...interface{}) *IndexFailedError {
+	res := &IndexFailedError{
+		IndexErrors: indexErrors,
+	}
+	res.setErrorf(format, args...)
+	return res
+}
+
 // BadResponseError represents "bad response" error
 type BadResponseError struct {
 	RavenError
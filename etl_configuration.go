@@ -0,0 +1,34 @@
+package ravendb
+
+// EtlType describes a type of ETL task
+type EtlType = string
+
+const (
+	EtlTypeRaven = "Raven"
+	EtlTypeSQL   = "Sql"
+)
+
+// Transformation describes a script that transforms documents of given
+// collections before they are sent to the destination. An empty Script
+// sends documents as they are
+type Transformation struct {
+	Name                string   `json:"Name"`
+	Disabled            bool     `json:"Disabled"`
+	Collections         []string `json:"Collections"`
+	ApplyToAllDocuments bool     `json:"ApplyToAllDocuments"`
+	Script              string   `json:"Script"`
+}
+
+// EtlConfiguration describes properties common to all types of ETL tasks
+type EtlConfiguration struct {
+	// TaskID is 0 when creating a new task
+	TaskID                        int64             `json:"TaskId"`
+	Name                          string            `json:"Name"`
+	MentorNode                    string            `json:"MentorNode,omitempty"`
+	ConnectionStringName          string            `json:"ConnectionStringName"`
+	Transforms                    []*Transformation `json:"Transforms"`
+	Disabled                      bool              `json:"Disabled"`
+	AllowEtlOnNonEncryptedChannel bool              `json:"AllowEtlOnNonEncryptedChannel"`
+	// Note: Java has this as a virtual function getEtlType()
+	EtlType EtlType `json:"EtlType"`
+}
@@ -0,0 +1,140 @@
+package ravendb
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEtlCommands(t *testing.T) {
+	srv := newFakeServer(t)
+	srv.respond("PUT /databases/db/admin/etl", `{"RaftCommandIndex":5,"TaskId":12}`)
+	srv.respond("RESET /databases/db/admin/etl", "")
+	srv.respond("/databases/db/admin/tasks/state", `{"TaskId":12,"RaftCommandIndex":7}`)
+	srv.handle("/databases/db/task", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("key") {
+		case "11":
+			_, _ = w.Write([]byte(`{"TaskId":11,"TaskType":"RavenEtl","TaskState":"Enabled","TaskName":"raven","DestinationUrl":"http://127.0.0.1:8080","DestinationDatabase":"replica","ConnectionStringName":"raven-cs","Configuration":{"Name":"raven","EtlType":"Raven","ConnectionStringName":"raven-cs","LoadRequestTimeoutInSec":30}}`))
+		case "12":
+			_, _ = w.Write([]byte(`{"TaskId":12,"TaskType":"SqlEtl","TaskState":"Disabled","TaskName":"sql","Configuration":{"Name":"sql","EtlType":"Sql","ConnectionStringName":"cs","SqlTables":[{"TableName":"Orders","DocumentIdColumn":"Id"}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	re := srv.newRequestExecutor(t, nil)
+	conventions := re.GetConventions()
+
+	ravenConfiguration := NewRavenEtlConfiguration()
+	ravenConfiguration.Name = "raven"
+	ravenConfiguration.ConnectionStringName = "raven-cs"
+	ravenConfiguration.Transforms = []*Transformation{
+		{
+			Name:        "users",
+			Collections: []string{"Users"},
+			Script:      "loadToUsers(this)",
+		},
+	}
+	timeout := int64(30)
+	ravenConfiguration.LoadRequestTimeoutInSec = &timeout
+
+	addOp, err := NewAddEtlOperation(ravenConfiguration)
+	require.NoError(t, err)
+	cmd, err := addOp.GetCommand(conventions)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, int64(12), addOp.Command.Result.TaskID)
+	body := srv.lastRequest().Body
+	assert.Equal(t, "Raven", body["EtlType"])
+	assert.Equal(t, "raven", body["Name"])
+	assert.Equal(t, "raven-cs", body["ConnectionStringName"])
+	assert.Equal(t, float64(30), body["LoadRequestTimeoutInSec"])
+	transform := body["Transforms"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "loadToUsers(this)", transform["Script"])
+
+	infoOp := NewGetOngoingTaskInfoOperation(11, OngoingTaskTypeRavenEtl)
+	cmd, err = infoOp.GetCommand(conventions)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+	ravenInfo, ok := infoOp.Command.Result.(*OngoingTaskRavenEtlDetails)
+	require.True(t, ok)
+	assert.Equal(t, "replica", ravenInfo.DestinationDatabase)
+	assert.Equal(t, "raven-cs", ravenInfo.Configuration.ConnectionStringName)
+	assert.Equal(t, int64(30), *ravenInfo.Configuration.LoadRequestTimeoutInSec)
+
+	configuration := NewSqlEtlConfiguration()
+	configuration.Name = "sql"
+	configuration.ConnectionStringName = "cs"
+	configuration.Transforms = []*Transformation{
+		{
+			Name:        "orders",
+			Collections: []string{"Orders"},
+			Script:      "loadToOrders(this)",
+		},
+	}
+	configuration.SqlTables = []*SqlEtlTable{
+		{TableName: "Orders", DocumentIDColumn: "Id"},
+	}
+
+	_, err = NewAddEtlOperation(configuration.EtlConfiguration)
+	assert.Error(t, err)
+	var nilConfiguration *RavenEtlConfiguration
+	_, err = NewAddEtlOperation(nilConfiguration)
+	assert.Error(t, err)
+
+	addOp, err = NewAddEtlOperation(configuration)
+	require.NoError(t, err)
+	cmd, err = addOp.GetCommand(conventions)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, int64(12), addOp.Command.Result.TaskID)
+	body = srv.lastRequest().Body
+	assert.Equal(t, "Sql", body["EtlType"])
+	assert.Equal(t, "cs", body["ConnectionStringName"])
+	assert.Equal(t, true, body["ParameterizeDeletes"])
+	table := body["SqlTables"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "Id", table["DocumentIdColumn"])
+
+	updateOp, err := NewUpdateEtlOperation(12, configuration)
+	require.NoError(t, err)
+	cmd, err = updateOp.GetCommand(conventions)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, int64(12), updateOp.Command.Result.TaskID)
+	assert.Equal(t, "12", srv.lastRequest().URL.Query().Get("id"))
+	assert.Equal(t, "sql", srv.lastRequest().Body["Name"])
+
+	resetOp := NewResetEtlOperation("sql", "orders")
+	cmd, err = resetOp.GetCommand(conventions)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+	q := srv.lastRequest().URL.Query()
+	assert.Equal(t, "sql", q.Get("configurationName"))
+	assert.Equal(t, "orders", q.Get("transformationName"))
+
+	toggleOp := NewToggleOngoingTaskStateOperation(12, OngoingTaskTypeSQLEtl, true)
+	cmd, err = toggleOp.GetCommand(conventions)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+	q = srv.lastRequest().URL.Query()
+	assert.Equal(t, "12", q.Get("key"))
+	assert.Equal(t, "SqlEtl", q.Get("type"))
+	assert.Equal(t, "true", q.Get("disable"))
+
+	infoOp = NewGetOngoingTaskInfoOperation(12, OngoingTaskTypeSQLEtl)
+	cmd, err = infoOp.GetCommand(conventions)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+	info, ok := infoOp.Command.Result.(*OngoingTaskSqlEtlDetails)
+	require.True(t, ok)
+	assert.Equal(t, OngoingTaskStateDisabled, info.TaskState)
+	assert.Equal(t, "Orders", info.Configuration.SqlTables[0].TableName)
+
+	infoOp = NewGetOngoingTaskInfoOperationWithName("missing", OngoingTaskTypeRavenEtl)
+	cmd, err = infoOp.GetCommand(conventions)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+	assert.Equal(t, "missing", srv.lastRequest().URL.Query().Get("taskName"))
+	assert.Nil(t, infoOp.Command.Result)
+}
@@ -0,0 +1,44 @@
+package ravendb
+
+import "strings"
+
+// ExplanationOptions describes options for score explanations
+type ExplanationOptions struct {
+	// GroupKey is a name of the field used to key explanations.
+	// If empty, explanations are keyed by document id
+	GroupKey string `json:"GroupKey,omitempty"`
+}
+
+// Explanations contains explanations of how scores of query results
+// were calculated, returned from a query with DocumentQuery.ExplainScores()
+type Explanations struct {
+	explanations map[string][]string
+}
+
+// GetKeys returns keys of explanations i.e. document ids or values of
+// ExplanationOptions.GroupKey field
+func (e *Explanations) GetKeys() []string {
+	var res []string
+	for key := range e.explanations {
+		res = append(res, key)
+	}
+	return res
+}
+
+// GetExplanations returns explanations for a given key (usually document id)
+func (e *Explanations) GetExplanations(key string) []string {
+	return e.explanations[key]
+}
+
+func (e *Explanations) update(queryResult *QueryResult) {
+	e.explanations = queryResult.Explanations
+}
+
+// joinExplanations converts explanations to a format of QueryStatistics.ScoreExplanations
+func joinExplanations(explanations map[string][]string) map[string]string {
+	res := map[string]string{}
+	for key, v := range explanations {
+		res[key] = strings.Join(v, "\n")
+	}
+	return res
+}
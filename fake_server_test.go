@@ -0,0 +1,118 @@
+package ravendb
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+// fakeServer is an http server for tests of commands. It responds to
+// requests with handlers registered for "<method> <path>" or "<path>"
+// (404 if there is none) and records the requests
+type fakeServer struct {
+	URL string
+
+	srv *httptest.Server
+
+	mu       sync.Mutex
+	handlers map[string]http.HandlerFunc
+	requests []*fakeRequest
+}
+
+// fakeRequest is a request received by fakeServer
+type fakeRequest struct {
+	Method string
+	URL    *url.URL
+	// Body is decoded JSON body, nil if the body is empty or not JSON
+	Body map[string]interface{}
+}
+
+// newFakeServer starts a fakeServer that is closed when the test ends
+func newFakeServer(t *testing.T) *fakeServer {
+	s := &fakeServer{
+		handlers: map[string]http.HandlerFunc{},
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Close shuts down the server. It can be called before the test ends
+func (s *fakeServer) Close() {
+	s.srv.Close()
+}
+
+// handle registers a handler for pattern "<method> <path>" or "<path>"
+func (s *fakeServer) handle(pattern string, h http.HandlerFunc) {
+	s.mu.Lock()
+	s.handlers[pattern] = h
+	s.mu.Unlock()
+}
+
+// respond registers a handler that responds with a given JSON body or
+// with 204 No Content if the body is empty
+func (s *fakeServer) respond(pattern string, body string) {
+	s.handle(pattern, func(w http.ResponseWriter, r *http.Request) {
+		if body == "" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	})
+}
+
+// newRequestExecutor returns a request executor for database "db" on this
+// server. It's closed when the test ends
+func (s *fakeServer) newRequestExecutor(t *testing.T, conventions *DocumentConventions) *RequestExecutor {
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(s.URL, "db", nil, nil, conventions)
+	t.Cleanup(re.Close)
+	return re
+}
+
+func (s *fakeServer) getRequests() []*fakeRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*fakeRequest(nil), s.requests...)
+}
+
+func (s *fakeServer) lastRequest() *fakeRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.requests) == 0 {
+		return nil
+	}
+	return s.requests[len(s.requests)-1]
+}
+
+func (s *fakeServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	req := &fakeRequest{
+		Method: r.Method,
+		URL:    r.URL,
+	}
+	if r.Body != nil {
+		d, _ := ioutil.ReadAll(r.Body)
+		_ = json.Unmarshal(d, &req.Body)
+		// handlers can read the body too
+		r.Body = ioutil.NopCloser(bytes.NewReader(d))
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	h := s.handlers[r.Method+" "+r.URL.Path]
+	if h == nil {
+		h = s.handlers[r.URL.Path]
+	}
+	s.mu.Unlock()
+
+	if h == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	h(w, r)
+}
@@ -5,9 +5,14 @@ type GenericQueryResult struct {
 	queryResultBase
 	TotalResults   int `json:"TotalResults"`
 	SkippedResults int `json:"SkippedResults"`
-	//TBD 4.1  map[string]map[string]List<String>>> highlightings
-	DurationInMs      int64              `json:"DurationInMs"`
-	ScoreExplanations map[string]string  `json:"ScoreExplanation"`
-	TimingsInMs       map[string]float64 `json:"TimingsInMs"`
-	ResultSize        int64              `json:"ResultSize"`
+	// highlighted fragments keyed by field name and document id
+	Highlightings     map[string]map[string][]string `json:"Highlightings"`
+	DurationInMs      int64                          `json:"DurationInMs"`
+	ScoreExplanations map[string]string              `json:"ScoreExplanation"`
+	TimingsInMs       map[string]float64             `json:"TimingsInMs"`
+	ResultSize        int64                          `json:"ResultSize"`
+	// time spent in each stage of the query, if requested with include timings()
+	Timings *QueryTimings `json:"Timings"`
+	// score explanations keyed by document id, if requested with include explanations()
+	Explanations map[string][]string `json:"Explanations"`
 }
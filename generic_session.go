@@ -0,0 +1,318 @@
+package ravendb
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// Type-safe variants of session APIs. They are thin wrappers around
+// functions that take interface{} arguments
+
+// Load loads an entity of type T with a given id.
+// Returns nil if the entity doesn't exist
+func Load[T any](session *DocumentSession, id string) (*T, error) {
+	return LoadCtx[T](context.Background(), session, id)
+}
+
+// LoadCtx is like Load but the request to the server is cancelled
+// when ctx is done
+func LoadCtx[T any](ctx context.Context, session *DocumentSession, id string) (*T, error) {
+	var result *T
+	if err := session.LoadCtx(ctx, &result, id); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// LoadMulti loads entities of type T with given ids. Returns a map from id
+// to entity. The entity is nil if it doesn't exist
+func LoadMulti[T any](session *DocumentSession, ids []string) (map[string]*T, error) {
+	return LoadMultiCtx[T](context.Background(), session, ids)
+}
+
+// LoadMultiCtx is like LoadMulti but the request to the server is cancelled
+// when ctx is done
+func LoadMultiCtx[T any](ctx context.Context, session *DocumentSession, ids []string) (map[string]*T, error) {
+	results := map[string]*T{}
+	if err := session.LoadMultiCtx(ctx, results, ids); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// TypedDocumentQuery is a DocumentQuery returning results of type T.
+// It wraps the most common methods of DocumentQuery. For others, use
+// DocumentQuery(), which returns the underlying query
+type TypedDocumentQuery[T any] struct {
+	q *DocumentQuery
+}
+
+// Query creates a new query over documents of a collection of type T
+func Query[T any](session *DocumentSession) *TypedDocumentQuery[T] {
+	return &TypedDocumentQuery[T]{
+		q: session.QueryCollectionForType(reflect.TypeOf((*T)(nil))),
+	}
+}
+
+// QueryIndex creates a new query in an index with a given name, returning
+// results of type T
+func QueryIndex[T any](session *DocumentSession, indexName string) *TypedDocumentQuery[T] {
+	return &TypedDocumentQuery[T]{
+		q: session.QueryIndex(indexName),
+	}
+}
+
+// DocumentQuery returns the underlying DocumentQuery. Changes to it are
+// reflected in this query
+func (q *TypedDocumentQuery[T]) DocumentQuery() *DocumentQuery {
+	return q.q
+}
+
+func (q *TypedDocumentQuery[T]) Where(fieldName string, op string, value interface{}) *TypedDocumentQuery[T] {
+	q.q.Where(fieldName, op, value)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) WhereEquals(fieldName string, value interface{}) *TypedDocumentQuery[T] {
+	q.q.WhereEquals(fieldName, value)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) WhereNotEquals(fieldName string, value interface{}) *TypedDocumentQuery[T] {
+	q.q.WhereNotEquals(fieldName, value)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) WhereIn(fieldName string, values []interface{}) *TypedDocumentQuery[T] {
+	q.q.WhereIn(fieldName, values)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) WhereStartsWith(fieldName string, value interface{}) *TypedDocumentQuery[T] {
+	q.q.WhereStartsWith(fieldName, value)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) WhereEndsWith(fieldName string, value interface{}) *TypedDocumentQuery[T] {
+	q.q.WhereEndsWith(fieldName, value)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) WhereBetween(fieldName string, start interface{}, end interface{}) *TypedDocumentQuery[T] {
+	q.q.WhereBetween(fieldName, start, end)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) WhereGreaterThan(fieldName string, value interface{}) *TypedDocumentQuery[T] {
+	q.q.WhereGreaterThan(fieldName, value)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) WhereGreaterThanOrEqual(fieldName string, value interface{}) *TypedDocumentQuery[T] {
+	q.q.WhereGreaterThanOrEqual(fieldName, value)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) WhereLessThan(fieldName string, value interface{}) *TypedDocumentQuery[T] {
+	q.q.WhereLessThan(fieldName, value)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) WhereLessThanOrEqual(fieldName string, value interface{}) *TypedDocumentQuery[T] {
+	q.q.WhereLessThanOrEqual(fieldName, value)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) WhereExists(fieldName string) *TypedDocumentQuery[T] {
+	q.q.WhereExists(fieldName)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) Search(fieldName string, searchTerms string) *TypedDocumentQuery[T] {
+	q.q.Search(fieldName, searchTerms)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) AndAlso() *TypedDocumentQuery[T] {
+	q.q.AndAlso()
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) OrElse() *TypedDocumentQuery[T] {
+	q.q.OrElse()
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) Not() *TypedDocumentQuery[T] {
+	q.q.Not()
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) OpenSubclause() *TypedDocumentQuery[T] {
+	q.q.OpenSubclause()
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) CloseSubclause() *TypedDocumentQuery[T] {
+	q.q.CloseSubclause()
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) OrderBy(field string) *TypedDocumentQuery[T] {
+	q.q.OrderBy(field)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) OrderByDescending(field string) *TypedDocumentQuery[T] {
+	q.q.OrderByDescending(field)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) Take(count int) *TypedDocumentQuery[T] {
+	q.q.Take(count)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) Skip(count int) *TypedDocumentQuery[T] {
+	q.q.Skip(count)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) Include(path string) *TypedDocumentQuery[T] {
+	q.q.Include(path)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) NoTracking() *TypedDocumentQuery[T] {
+	q.q.NoTracking()
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) WaitForNonStaleResults(waitTimeout time.Duration) *TypedDocumentQuery[T] {
+	q.q.WaitForNonStaleResults(waitTimeout)
+	return q
+}
+
+func (q *TypedDocumentQuery[T]) Statistics(stats **QueryStatistics) *TypedDocumentQuery[T] {
+	q.q.Statistics(stats)
+	return q
+}
+
+// ToList runs the query and returns its results
+func (q *TypedDocumentQuery[T]) ToList() ([]*T, error) {
+	return q.ToListCtx(context.Background())
+}
+
+// ToListCtx is like ToList but the request to the server is cancelled
+// when ctx is done
+func (q *TypedDocumentQuery[T]) ToListCtx(ctx context.Context) ([]*T, error) {
+	var results []*T
+	if err := q.q.GetResultsCtx(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// First runs the query and returns its first result or nil if there are no results
+func (q *TypedDocumentQuery[T]) First() (*T, error) {
+	return q.FirstCtx(context.Background())
+}
+
+// FirstCtx is like First but the request to the server is cancelled
+// when ctx is done
+func (q *TypedDocumentQuery[T]) FirstCtx(ctx context.Context) (*T, error) {
+	var result *T
+	if err := q.q.FirstCtx(ctx, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Single runs a query that expects only a single result.
+// If there is more than one result, it returns IllegalStateError
+func (q *TypedDocumentQuery[T]) Single() (*T, error) {
+	return q.SingleCtx(context.Background())
+}
+
+// SingleCtx is like Single but the request to the server is cancelled
+// when ctx is done
+func (q *TypedDocumentQuery[T]) SingleCtx(ctx context.Context) (*T, error) {
+	var result *T
+	if err := q.q.SingleCtx(ctx, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Count returns number of results of the query
+func (q *TypedDocumentQuery[T]) Count() (int, error) {
+	return q.q.Count()
+}
+
+// Any returns true if the query returns at least one result
+func (q *TypedDocumentQuery[T]) Any() (bool, error) {
+	return q.q.Any()
+}
+
+// SelectJavaScript returns a query whose results of type P are computed
+// on the server with JavaScript projection
+func SelectJavaScript[P any, T any](query *TypedDocumentQuery[T], projection *JavaScriptProjection) *TypedDocumentQuery[P] {
+	return &TypedDocumentQuery[P]{
+		q: query.q.SelectJavaScript(reflect.TypeOf((*P)(nil)), projection),
+	}
+}
+
+// TypedStreamIterator iterates over results of type T of a streaming
+// query or streaming documents
+type TypedStreamIterator[T any] struct {
+	iterator   *StreamIterator
+	lastResult *StreamResult
+}
+
+// Next returns next result. Returns io.EOF error after the last result
+func (i *TypedStreamIterator[T]) Next() (*T, error) {
+	var result *T
+	streamResult, err := i.iterator.Next(&result)
+	if err != nil {
+		return nil, err
+	}
+	i.lastResult = streamResult
+	return result, nil
+}
+
+// Metadata returns metadata of the result returned by the last call to Next
+func (i *TypedStreamIterator[T]) Metadata() *MetadataAsDictionary {
+	if i.lastResult == nil {
+		return nil
+	}
+	return i.lastResult.Metadata
+}
+
+// Close closes the iterator
+func (i *TypedStreamIterator[T]) Close() error {
+	return i.iterator.Close()
+}
+
+// Stream streams documents of type T whose ids start with args.StartsWith
+func Stream[T any](session *DocumentSession, args *StartsWithArgs) (*TypedStreamIterator[T], error) {
+	iterator, err := session.Stream(args)
+	if err != nil {
+		return nil, err
+	}
+	return &TypedStreamIterator[T]{
+		iterator: iterator,
+	}, nil
+}
+
+// StreamQuery starts a streaming query and returns iterator for results.
+// If streamQueryStats is provided, it'll be filled with information about query statistics
+func StreamQuery[T any](session *DocumentSession, query *TypedDocumentQuery[T], streamQueryStats *StreamQueryStatistics) (*TypedStreamIterator[T], error) {
+	iterator, err := session.StreamQuery(query.q, streamQueryStats)
+	if err != nil {
+		return nil, err
+	}
+	return &TypedStreamIterator[T]{
+		iterator: iterator,
+	}, nil
+}
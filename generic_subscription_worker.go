@@ -0,0 +1,61 @@
+package ravendb
+
+import (
+	"reflect"
+)
+
+// TypedSubscriptionBatchItem is a SubscriptionBatchItem with result of type T
+type TypedSubscriptionBatchItem[T any] struct {
+	*SubscriptionBatchItem
+
+	// Result is nil if the server failed to process the document.
+	// In that case ErrorMessage describes the error
+	Result *T
+}
+
+// TypedSubscriptionBatch is a SubscriptionBatch with results of type T
+type TypedSubscriptionBatch[T any] struct {
+	*SubscriptionBatch
+
+	Items []*TypedSubscriptionBatchItem[T]
+}
+
+func newTypedSubscriptionBatch[T any](batch *SubscriptionBatch) *TypedSubscriptionBatch[T] {
+	res := &TypedSubscriptionBatch[T]{
+		SubscriptionBatch: batch,
+	}
+	for _, item := range batch.Items {
+		typedItem := &TypedSubscriptionBatchItem[T]{
+			SubscriptionBatchItem: item,
+		}
+		if v, ok := item.Result.(*T); ok {
+			typedItem.Result = v
+		}
+		res.Items = append(res.Items, typedItem)
+	}
+	return res
+}
+
+// TypedSubscriptionWorker is a SubscriptionWorker returning documents of type T
+type TypedSubscriptionWorker[T any] struct {
+	*SubscriptionWorker
+}
+
+// GetSubscriptionWorker opens a subscription for documents of type T.
+// See DocumentSubscriptions.GetSubscriptionWorker
+func GetSubscriptionWorker[T any](store *DocumentStore, options *SubscriptionWorkerOptions, database string) (*TypedSubscriptionWorker[T], error) {
+	worker, err := store.Subscriptions().GetSubscriptionWorker(reflect.TypeOf((*T)(nil)), options, database)
+	if err != nil {
+		return nil, err
+	}
+	return &TypedSubscriptionWorker[T]{
+		SubscriptionWorker: worker,
+	}, nil
+}
+
+// Run starts processing subscription batches with a given callback
+func (w *TypedSubscriptionWorker[T]) Run(cb func(*TypedSubscriptionBatch[T]) error) error {
+	return w.SubscriptionWorker.Run(func(batch *SubscriptionBatch) error {
+		return cb(newTypedSubscriptionBatch[T](batch))
+	})
+}
@@ -0,0 +1,119 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IOperation = &GetCountersOperation{}
+)
+
+// GetCountersOperation returns values of counters of a document
+type GetCountersOperation struct {
+	Command *GetCountersCommand
+
+	docID             string
+	counters          []string
+	returnFullResults bool
+}
+
+// NewGetCountersOperation returns new GetCountersOperation. If counters
+// is empty, all counters of the document are returned. returnFullResults
+// asks the server to also return per-node values of counters
+func NewGetCountersOperation(docID string, counters []string, returnFullResults bool) *GetCountersOperation {
+	return &GetCountersOperation{
+		docID:             docID,
+		counters:          counters,
+		returnFullResults: returnFullResults,
+	}
+}
+
+func (o *GetCountersOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewGetCountersCommand(o.docID, o.counters, o.returnFullResults)
+	return o.Command, err
+}
+
+var _ RavenCommand = &GetCountersCommand{}
+
+// GetCountersCommand represents a command for getting values of counters
+type GetCountersCommand struct {
+	RavenCommandBase
+
+	docID             string
+	counters          []string
+	returnFullResults bool
+
+	Result *CountersDetail
+}
+
+// NewGetCountersCommand returns new GetCountersCommand
+func NewGetCountersCommand(docID string, counters []string, returnFullResults bool) (*GetCountersCommand, error) {
+	if stringIsBlank(docID) {
+		return nil, newIllegalArgumentError("DocId cannot be empty")
+	}
+	cmd := &GetCountersCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		docID:             docID,
+		counters:          counters,
+		returnFullResults: returnFullResults,
+	}
+	cmd.IsReadRequest = true
+	return cmd, nil
+}
+
+func (c *GetCountersCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/counters"
+
+	counters := stringArrayRemoveDuplicates(stringArrayCopy(c.counters))
+	totalLen := 0
+	for _, counter := range counters {
+		totalLen += len(counter)
+	}
+
+	// if it is too big, we drop to POST (note that means that we can't use the HTTP cache any longer)
+	if totalLen > 1024 {
+		return c.prepareRequestWithManyCounters(url, counters)
+	}
+
+	url += "?docId=" + urlUtilsEscapeDataString(c.docID)
+	for _, counter := range counters {
+		url += "&counter=" + urlUtilsEscapeDataString(counter)
+	}
+	if c.returnFullResults {
+		url += "&full=true"
+	}
+	return newHttpGet(url)
+}
+
+func (c *GetCountersCommand) prepareRequestWithManyCounters(url string, counters []string) (*http.Request, error) {
+	operations := make([]*CounterOperation, len(counters))
+	for i, counter := range counters {
+		operations[i] = &CounterOperation{
+			Type:        CounterOperationTypeGet,
+			CounterName: counter,
+		}
+	}
+	batch := &CounterBatch{
+		ReplyWithAllNodesValues: c.returnFullResults,
+		Documents: []*DocumentCountersOperation{
+			{
+				DocumentID: c.docID,
+				Operations: operations,
+			},
+		},
+	}
+	d, err := jsonMarshal(batch)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+func (c *GetCountersCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return nil
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
@@ -1,12 +1,15 @@
 package ravendb
 
 import (
+	"bytes"
+	"io"
 	"net/http"
 	"strconv"
 )
 
 var (
-	_ RavenCommand = &GetDocumentsCommand{}
+	_ RavenCommand                  = &GetDocumentsCommand{}
+	_ ravenCommandStreamingResponse = &GetDocumentsCommand{}
 )
 
 type GetDocumentsCommand struct {
@@ -17,6 +20,11 @@ type GetDocumentsCommand struct {
 	_ids      []string
 	_includes []string
 
+	_counters           []string
+	_includeAllCounters bool
+
+	_timeSeriesIncludes []*TimeSeriesRange
+
 	_metadataOnly bool
 
 	_startWith  string
@@ -52,6 +60,19 @@ func NewGetDocumentsCommand(ids []string, includes []string, metadataOnly bool)
 	return cmd, nil
 }
 
+// NewGetDocumentsCommandWithCounters returns GetDocumentsCommand that also
+// includes given counters (or all counters if includeAllCounters is true)
+// of the documents
+func NewGetDocumentsCommandWithCounters(ids []string, includes []string, counterIncludes []string, includeAllCounters bool, metadataOnly bool) (*GetDocumentsCommand, error) {
+	cmd, err := NewGetDocumentsCommand(ids, includes, metadataOnly)
+	if err != nil {
+		return nil, err
+	}
+	cmd._counters = counterIncludes
+	cmd._includeAllCounters = includeAllCounters
+	return cmd, nil
+}
+
 func NewGetDocumentsCommandFull(startWith string, startAfter string, matches string, exclude string, start int, pageSize int, metadataOnly bool) (*GetDocumentsCommand, error) {
 	if startWith == "" {
 		return nil, newIllegalArgumentError("startWith cannot be null")
@@ -108,6 +129,26 @@ func (c *GetDocumentsCommand) CreateRequest(node *ServerNode) (*http.Request, er
 		url += include
 	}
 
+	if c._includeAllCounters {
+		url += "&counter=" + countersAll
+	} else {
+		for _, counter := range c._counters {
+			url += "&counter=" + urlUtilsEscapeDataString(counter)
+		}
+	}
+
+	for _, r := range c._timeSeriesIncludes {
+		url += "&timeseries=" + urlUtilsEscapeDataString(r.Name)
+		url += "&from="
+		if r.From != nil {
+			url += urlUtilsEscapeDataString(formatTimeSeriesTime(*r.From))
+		}
+		url += "&to="
+		if r.To != nil {
+			url += urlUtilsEscapeDataString(formatTimeSeriesTime(*r.To))
+		}
+	}
+
 	if c._id != "" {
 		url += "&id="
 		url += urlUtilsEscapeDataString(c._id)
@@ -158,9 +199,16 @@ func (c *GetDocumentsCommand) calculateHash(uniqueIds []string) string {
 }
 
 func (c *GetDocumentsCommand) SetResponse(response []byte, fromCache bool) error {
-	if len(response) == 0 {
-		return nil
-	}
+	return c.setResponseStream(bytes.NewReader(response), fromCache)
+}
 
-	return jsonUnmarshal(response, &c.Result)
+// documents are decoded as maps because session needs them for change tracking
+func (c *GetDocumentsCommand) setResponseStream(r io.Reader, fromCache bool) error {
+	var results []map[string]interface{}
+	err := jsonDecodeStream(r, &c.Result, "Results", jsonDecodeMapsStream(&results))
+	if err != nil || c.Result == nil {
+		return err
+	}
+	c.Result.Results = results
+	return nil
 }
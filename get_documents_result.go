@@ -5,4 +5,8 @@ type GetDocumentsResult struct {
 	Includes      map[string]interface{}   `json:"Includes"`
 	Results       []map[string]interface{} `json:"Results"`
 	NextPageStart int                      `json:"NextPageStart"`
+	// counters included with include counters, keyed by document id
+	CounterIncludes map[string][]*CounterDetail `json:"CounterIncludes"`
+	// time series ranges included with include timeseries, keyed by document id and time series name
+	TimeSeriesIncludes map[string]map[string][]*TimeSeriesRangeResult `json:"TimeSeriesIncludes"`
 }
@@ -9,7 +9,8 @@ var (
 )
 
 type _GetNextOperationIDCommandResponse struct {
-	ID int64 `json:"Id"`
+	ID      int64  `json:"Id"`
+	NodeTag string `json:"NodeTag"`
 }
 
 // GetNextOperationIDCommand represents command for getting next
@@ -18,6 +19,9 @@ type GetNextOperationIDCommand struct {
 	RavenCommandBase
 
 	Result int64
+	// NodeTag is a cluster tag of the node that returned the id. Operation
+	// with this id must be started on this node
+	NodeTag string
 }
 
 // NewGetNextOperationIDCommand returns GetNextOperationIDCommand
@@ -40,5 +44,6 @@ func (c *GetNextOperationIDCommand) SetResponse(response []byte, fromCache bool)
 		return err
 	}
 	c.Result = res.ID
+	c.NodeTag = res.NodeTag
 	return nil
 }
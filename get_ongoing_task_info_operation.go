@@ -0,0 +1,131 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &GetOngoingTaskInfoOperation{}
+)
+
+// GetOngoingTaskInfoOperation returns information about an ongoing task
+// (replication, ETL, backup etc.) identified by id or by name
+type GetOngoingTaskInfoOperation struct {
+	taskID   int64
+	taskName string
+	taskType OngoingTaskType
+
+	Command *GetOngoingTaskInfoCommand
+}
+
+// NewGetOngoingTaskInfoOperation returns new GetOngoingTaskInfoOperation
+// for a task with a given id
+func NewGetOngoingTaskInfoOperation(taskID int64, taskType OngoingTaskType) *GetOngoingTaskInfoOperation {
+	return &GetOngoingTaskInfoOperation{
+		taskID:   taskID,
+		taskType: taskType,
+	}
+}
+
+// NewGetOngoingTaskInfoOperationWithName returns new GetOngoingTaskInfoOperation
+// for a task with a given name
+func NewGetOngoingTaskInfoOperationWithName(taskName string, taskType OngoingTaskType) *GetOngoingTaskInfoOperation {
+	return &GetOngoingTaskInfoOperation{
+		taskName: taskName,
+		taskType: taskType,
+	}
+}
+
+// GetCommand returns a command
+func (o *GetOngoingTaskInfoOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewGetOngoingTaskInfoCommand(o.taskID, o.taskName, o.taskType)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
+
+var _ RavenCommand = &GetOngoingTaskInfoCommand{}
+
+// GetOngoingTaskInfoCommand returns information about an ongoing task.
+// Depending on the task type Result is *OngoingTaskReplication,
+// *OngoingTaskRavenEtlDetails, *OngoingTaskSqlEtlDetails, *OngoingTaskBackup
+// or *OngoingTask for other types. Result is nil if the task doesn't exist
+type GetOngoingTaskInfoCommand struct {
+	RavenCommandBase
+
+	taskID   int64
+	taskName string
+	taskType OngoingTaskType
+
+	Result interface{}
+}
+
+// NewGetOngoingTaskInfoCommand returns new GetOngoingTaskInfoCommand.
+// The task is identified by taskName if it's not empty, by taskID otherwise
+func NewGetOngoingTaskInfoCommand(taskID int64, taskName string, taskType OngoingTaskType) (*GetOngoingTaskInfoCommand, error) {
+	if taskType == "" {
+		return nil, newIllegalArgumentError("TaskType cannot be empty")
+	}
+	cmd := &GetOngoingTaskInfoCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		taskID:   taskID,
+		taskName: taskName,
+		taskType: taskType,
+	}
+	cmd.IsReadRequest = true
+	return cmd, nil
+}
+
+func (c *GetOngoingTaskInfoCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/task?"
+	if c.taskName != "" {
+		url += "taskName=" + urlUtilsEscapeDataString(c.taskName)
+	} else {
+		url += "key=" + i64toa(c.taskID)
+	}
+	url += "&type=" + c.taskType
+	return newHttpGet(url)
+}
+
+func (c *GetOngoingTaskInfoCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		c.Result = nil
+		return nil
+	}
+	switch c.taskType {
+	case OngoingTaskTypeReplication:
+		var res *OngoingTaskReplication
+		if err := jsonUnmarshal(response, &res); err != nil {
+			return err
+		}
+		c.Result = res
+	case OngoingTaskTypeRavenEtl:
+		var res *OngoingTaskRavenEtlDetails
+		if err := jsonUnmarshal(response, &res); err != nil {
+			return err
+		}
+		c.Result = res
+	case OngoingTaskTypeSQLEtl:
+		var res *OngoingTaskSqlEtlDetails
+		if err := jsonUnmarshal(response, &res); err != nil {
+			return err
+		}
+		c.Result = res
+	case OngoingTaskTypeBackup:
+		var res *OngoingTaskBackup
+		if err := jsonUnmarshal(response, &res); err != nil {
+			return err
+		}
+		c.Result = res
+	default:
+		var res *OngoingTask
+		if err := jsonUnmarshal(response, &res); err != nil {
+			return err
+		}
+		c.Result = res
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &GetPeriodicBackupStatusOperation{}
+)
+
+// GetPeriodicBackupStatusOperation returns the status of a periodic backup task
+type GetPeriodicBackupStatusOperation struct {
+	taskID int64
+
+	Command *GetPeriodicBackupStatusCommand
+}
+
+// NewGetPeriodicBackupStatusOperation returns new GetPeriodicBackupStatusOperation
+func NewGetPeriodicBackupStatusOperation(taskID int64) *GetPeriodicBackupStatusOperation {
+	return &GetPeriodicBackupStatusOperation{
+		taskID: taskID,
+	}
+}
+
+// GetCommand returns a command
+func (o *GetPeriodicBackupStatusOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewGetPeriodicBackupStatusCommand(o.taskID)
+	return o.Command, nil
+}
+
+// GetPeriodicBackupStatusOperationResult is a result of GetPeriodicBackupStatusCommand.
+// Status is nil if the task hasn't run yet
+type GetPeriodicBackupStatusOperationResult struct {
+	Status *PeriodicBackupStatus `json:"Status"`
+}
+
+var _ RavenCommand = &GetPeriodicBackupStatusCommand{}
+
+// GetPeriodicBackupStatusCommand returns the status of a periodic backup task
+type GetPeriodicBackupStatusCommand struct {
+	RavenCommandBase
+
+	taskID int64
+
+	Result *GetPeriodicBackupStatusOperationResult
+}
+
+// NewGetPeriodicBackupStatusCommand returns new GetPeriodicBackupStatusCommand
+func NewGetPeriodicBackupStatusCommand(taskID int64) *GetPeriodicBackupStatusCommand {
+	cmd := &GetPeriodicBackupStatusCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		taskID: taskID,
+	}
+	cmd.IsReadRequest = true
+	return cmd
+}
+
+func (c *GetPeriodicBackupStatusCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/periodic-backup/status?name=" + urlUtilsEscapeDataString(node.Database) + "&taskId=" + i64toa(c.taskID)
+	return newHttpGet(url)
+}
+
+func (c *GetPeriodicBackupStatusCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
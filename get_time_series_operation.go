@@ -0,0 +1,108 @@
+package ravendb
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var (
+	_ IOperation = &GetTimeSeriesOperation{}
+)
+
+// GetTimeSeriesOperation returns entries of a time series between from and to
+type GetTimeSeriesOperation struct {
+	Command *GetTimeSeriesCommand
+
+	docID    string
+	name     string
+	from     *time.Time
+	to       *time.Time
+	start    int
+	pageSize int
+}
+
+// NewGetTimeSeriesOperation returns new GetTimeSeriesOperation.
+// nil from or to means the range is unbounded. pageSize of 0 means no limit
+func NewGetTimeSeriesOperation(docID string, name string, from *time.Time, to *time.Time, start int, pageSize int) (*GetTimeSeriesOperation, error) {
+	if stringIsBlank(docID) {
+		return nil, newIllegalArgumentError("DocId cannot be empty")
+	}
+	if stringIsBlank(name) {
+		return nil, newIllegalArgumentError("Timeseries cannot be empty")
+	}
+	return &GetTimeSeriesOperation{
+		docID:    docID,
+		name:     name,
+		from:     from,
+		to:       to,
+		start:    start,
+		pageSize: pageSize,
+	}, nil
+}
+
+func (o *GetTimeSeriesOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
+	o.Command = newGetTimeSeriesCommand(o.docID, o.name, o.from, o.to, o.start, o.pageSize)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &GetTimeSeriesCommand{}
+
+// GetTimeSeriesCommand represents a command for getting a range of a time series
+type GetTimeSeriesCommand struct {
+	RavenCommandBase
+
+	docID    string
+	name     string
+	from     *time.Time
+	to       *time.Time
+	start    int
+	pageSize int
+
+	// Result is nil if the document or the time series doesn't exist
+	Result *TimeSeriesRangeResult
+}
+
+func newGetTimeSeriesCommand(docID string, name string, from *time.Time, to *time.Time, start int, pageSize int) *GetTimeSeriesCommand {
+	if pageSize <= 0 {
+		pageSize = math.MaxInt32
+	}
+	cmd := &GetTimeSeriesCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		docID:    docID,
+		name:     name,
+		from:     from,
+		to:       to,
+		start:    start,
+		pageSize: pageSize,
+	}
+	cmd.IsReadRequest = true
+	return cmd
+}
+
+func (c *GetTimeSeriesCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/timeseries?docId=" + urlUtilsEscapeDataString(c.docID)
+	if c.start > 0 {
+		url += "&start=" + strconv.Itoa(c.start)
+	}
+	if c.pageSize < math.MaxInt32 {
+		url += "&pageSize=" + strconv.Itoa(c.pageSize)
+	}
+	url += "&name=" + urlUtilsEscapeDataString(c.name)
+	if c.from != nil {
+		url += "&from=" + urlUtilsEscapeDataString(formatTimeSeriesTime(*c.from))
+	}
+	if c.to != nil {
+		url += "&to=" + urlUtilsEscapeDataString(formatTimeSeriesTime(*c.to))
+	}
+	return newHttpGet(url)
+}
+
+func (c *GetTimeSeriesCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return nil
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
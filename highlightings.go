@@ -0,0 +1,65 @@
+package ravendb
+
+// HighlightingOptions describes options for query highlighting
+type HighlightingOptions struct {
+	// GroupKey is a name of the field used to key highlighting results.
+	// If empty, results are keyed by document id
+	GroupKey string `json:"GroupKey,omitempty"`
+	// PreTags and PostTags are inserted around highlighted fragments.
+	// If more than one is given, they're used for subsequent terms
+	PreTags  []string `json:"PreTags,omitempty"`
+	PostTags []string `json:"PostTags,omitempty"`
+}
+
+// Highlightings contains highlighted fragments of a single field,
+// returned from a query with DocumentQuery.Highlight()
+type Highlightings struct {
+	FieldName string
+
+	highlightings map[string][]string
+}
+
+func newHighlightings(fieldName string) *Highlightings {
+	return &Highlightings{
+		FieldName:     fieldName,
+		highlightings: map[string][]string{},
+	}
+}
+
+// GetResultIndents returns keys of highlighting results i.e. document ids
+// or values of HighlightingOptions.GroupKey field
+func (h *Highlightings) GetResultIndents() []string {
+	var res []string
+	for key := range h.highlightings {
+		res = append(res, key)
+	}
+	return res
+}
+
+// GetFragments returns highlighted fragments for a given key (usually document id)
+func (h *Highlightings) GetFragments(key string) []string {
+	return h.highlightings[key]
+}
+
+func (h *Highlightings) update(highlightings map[string]map[string][]string) {
+	h.highlightings = map[string][]string{}
+	for key, fragments := range highlightings[h.FieldName] {
+		h.highlightings[key] = fragments
+	}
+}
+
+type queryHighlightings struct {
+	highlightings []*Highlightings
+}
+
+func (h *queryHighlightings) add(fieldName string) *Highlightings {
+	res := newHighlightings(fieldName)
+	h.highlightings = append(h.highlightings, res)
+	return res
+}
+
+func (h *queryHighlightings) update(queryResult *QueryResult) {
+	for _, highlighting := range h.highlightings {
+		highlighting.update(queryResult.Highlightings)
+	}
+}
@@ -0,0 +1,47 @@
+package ravendb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type highlightingsTestPost struct {
+	ID    string
+	Title string
+}
+
+func TestProjectionSharesHighlightings(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Results": [], "Highlightings": {"Title": {"posts/1": ["<b>go</b>"]}}}`))
+	}))
+	defer srv.Close()
+
+	store := NewDocumentStore([]string{srv.URL}, "db")
+	store.GetConventions().SetDisableTopologyUpdates(true)
+	require.NoError(t, store.Initialize())
+	defer store.Close()
+	session, err := store.OpenSession("")
+	require.NoError(t, err)
+	defer session.Close()
+
+	var highlightings *Highlightings
+	query := session.QueryCollection("posts").
+		Search("Title", "go").
+		Highlight("Title", 128, 1, &highlightings)
+	projection := query.SelectFields(reflect.TypeOf(&highlightingsTestPost{}), "Title")
+	require.NoError(t, projection.Err())
+
+	iq, err := projection.GetIndexQuery()
+	require.NoError(t, err)
+	assert.Equal(t, "from posts where search(Title, $p0) select Title include highlight(Title,128,1)", iq.GetQuery())
+
+	// executing the projection fills highlightings of the query it was derived from
+	var posts []*highlightingsTestPost
+	require.NoError(t, projection.GetResults(&posts))
+	assert.Equal(t, []string{"<b>go</b>"}, highlightings.GetFragments("posts/1"))
+}
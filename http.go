@@ -2,8 +2,10 @@ package ravendb
 
 import (
 	"bytes"
+	"compress/gzip"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 )
@@ -24,6 +26,75 @@ func addCommonHeaders(req *http.Request) {
 	req.Header.Add("User-Agent", "ravendb-go-client/4.0.0")
 }
 
+func gzipBytes(d []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(d); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// newGzipReader returns a reader that returns gzip-compressed content of body.
+// Compression happens in a goroutine as data is being read
+func newGzipReader(body io.ReadCloser) io.ReadCloser {
+	r, w := io.Pipe()
+	go func() {
+		gw := gzip.NewWriter(w)
+		_, err := io.Copy(gw, body)
+		if err == nil {
+			err = gw.Close()
+		}
+		_ = body.Close()
+		_ = w.CloseWithError(err)
+	}()
+	return r
+}
+
+// compressRequestBody replaces body of the request with its version
+// compressed with a given algorithm
+func compressRequestBody(req *http.Request, algorithm CompressionAlgorithm) error {
+	switch algorithm {
+	case "", CompressionAlgorithmNone:
+		return nil
+	case CompressionAlgorithmGzip:
+		// supported
+	default:
+		return newIllegalArgumentError("Unsupported compression algorithm '%s'", algorithm)
+	}
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+
+	req.Header.Set("Content-Encoding", "gzip")
+	if req.GetBody == nil {
+		// a stream of unknown length, compress as it's being sent
+		req.Body = newGzipReader(req.Body)
+		req.ContentLength = -1
+		return nil
+	}
+
+	// body is in memory so we compress it upfront which keeps
+	// the request re-playable
+	d, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	d, err = gzipBytes(d)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = int64(len(d))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(d)), nil
+	}
+	req.Body, _ = req.GetBody()
+	return nil
+}
+
 func newHttpHead(uri string) (*http.Request, error) {
 	req, err := http.NewRequest(http.MethodHead, uri, nil)
 	if err != nil {
@@ -3,55 +3,142 @@ package ravendb
 import (
 	//"fmt"
 
+	"container/list"
 	"math"
 	"sync"
 	"sync/atomic"
 	"time"
 )
 
-// equivalent of com.google.common.cache.Cache, specialized for String -> HttpCacheItem mapping
-// TODO: match semantics
+// equivalent of com.google.common.cache.Cache, specialized for String -> HttpCacheItem mapping.
+// It's an LRU cache bounded by total weight of items (roughly, size of payload
+// in bytes). When adding an item would exceed maximumWeight, least recently
+// used items are evicted
 type genericCache struct {
-	softValues    bool
 	maximumWeight int
 	weighter      func(string, *httpCacheItem) int
 
-	data *sync.Map
+	mu          sync.Mutex
+	data        map[string]*list.Element // values are *genericCacheEntry
+	lru         *list.List               // front is most recently used
+	totalWeight int
+	evictions   int64
+}
+
+type genericCacheEntry struct {
+	uri    string
+	item   *httpCacheItem
+	weight int
+}
+
+func newGenericCache(maximumWeight int, weighter func(string, *httpCacheItem) int) *genericCache {
+	return &genericCache{
+		maximumWeight: maximumWeight,
+		weighter:      weighter,
+		data:          map[string]*list.Element{},
+		lru:           list.New(),
+	}
 }
 
 func (c *genericCache) size() int {
-	// this is probably only ever going to be called from tests
-	// fine to do O(N) work here
-	length := 0
-	c.data.Range(func(_, _ interface{}) bool {
-		length++
-		return true
-	})
-	return length
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.data)
+}
+
+func (c *genericCache) weight() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.totalWeight
+}
+
+func (c *genericCache) numberOfEvictions() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.evictions
 }
 
 func (c *genericCache) invalidateAll() {
-	c.data = &sync.Map{}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data = map[string]*list.Element{}
+	c.lru.Init()
+	c.totalWeight = 0
 }
 
 func (c *genericCache) getIfPresent(uri string) *httpCacheItem {
-	item, found := c.data.Load(uri)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, found := c.data[uri]
 	if !found {
 		return nil
 	}
-	return item.(*httpCacheItem)
+	c.lru.MoveToFront(el)
+	return el.Value.(*genericCacheEntry).item
 }
 
 func (c *genericCache) put(uri string, i *httpCacheItem) {
 	//fmt.Printf("genericCache.put(): url: %s, changeVector: %s, len(result): %d\n", uri, *i.changeVector, len(i.payload))
+	weight := c.weighter(uri, i)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, found := c.data[uri]; found {
+		c.removeElement(el)
+	}
+	if weight > c.maximumWeight {
+		// would evict everything else and still not fit
+		return
+	}
+	for c.totalWeight+weight > c.maximumWeight {
+		c.removeElement(c.lru.Back())
+		c.evictions++
+	}
+	entry := &genericCacheEntry{
+		uri:    uri,
+		item:   i,
+		weight: weight,
+	}
+	c.data[uri] = c.lru.PushFront(entry)
+	c.totalWeight += weight
+}
 
-	// TODO: probably implement cache eviction
-	c.data.Store(uri, i)
+// must be called with c.mu locked
+func (c *genericCache) removeElement(el *list.Element) {
+	entry := c.lru.Remove(el).(*genericCacheEntry)
+	delete(c.data, entry.uri)
+	c.totalWeight -= entry.weight
+}
+
+// HttpCacheStats describes usage of a http cache of a RequestExecutor
+type HttpCacheStats struct {
+	NumberOfItems  int
+	SizeInBytes    int64
+	MaxSizeInBytes int64
+	// number of requests for which we had a cached response
+	Hits int64
+	// number of requests for which we didn't have a cached response
+	Misses int64
+	// number of times the server confirmed that cached response is up to date
+	NotModified int64
+	// number of items removed to stay within MaxSizeInBytes
+	Evictions int64
 }
 
 type httpCache struct {
 	items      *genericCache
 	generation int32 // atomic
+
+	// statistics, atomic
+	hits        int64
+	misses      int64
+	notModified int64
+
+	// protects items from being closed while GetStats reads them
+	mu sync.Mutex
+	// number of evictions when the cache was closed
+	closedEvictions int64
 }
 
 func (c *httpCache) incGeneration() {
@@ -67,24 +154,49 @@ func newHttpCache(size int) *httpCache {
 	if size == 0 {
 		size = 1 * 1024 * 1024 // TODO: check what is default size of com.google.common.cache.Cache is
 	}
-	cache := &genericCache{
-		softValues:    true,
-		maximumWeight: size,
-		weighter: func(k string, v *httpCacheItem) int {
-			return len(v.payload) + 20
-		},
-		data: &sync.Map{ },
+	weighter := func(k string, v *httpCacheItem) int {
+		return len(v.payload) + 20
 	}
 	return &httpCache{
-		items: cache,
+		items: newGenericCache(size, weighter),
 	}
 }
 
+// maxItemSize returns size of the biggest response that can be cached.
+// Bigger responses would evict everything else and still not fit
+func (c *httpCache) maxItemSize() int {
+	return c.items.maximumWeight
+}
+
 func (c *httpCache) GetNumberOfItems() int {
 	return c.items.size()
 }
 
+// GetStats returns statistics of the cache. A closed cache has no items
+// but keeps its counters
+func (c *httpCache) GetStats() HttpCacheStats {
+	res := HttpCacheStats{
+		Hits:        atomic.LoadInt64(&c.hits),
+		Misses:      atomic.LoadInt64(&c.misses),
+		NotModified: atomic.LoadInt64(&c.notModified),
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.items == nil {
+		res.Evictions = c.closedEvictions
+		return res
+	}
+	res.NumberOfItems = c.items.size()
+	res.SizeInBytes = int64(c.items.weight())
+	res.MaxSizeInBytes = int64(c.items.maximumWeight)
+	res.Evictions = c.items.numberOfEvictions()
+	return res
+}
+
 func (c *httpCache) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closedEvictions = c.items.numberOfEvictions()
 	c.items.invalidateAll()
 	c.items = nil
 }
@@ -102,11 +214,13 @@ func (c *httpCache) set(url string, changeVector *string, result []byte) {
 func (c *httpCache) get(url string) (*releaseCacheItem, *string, []byte) {
 	item := c.items.getIfPresent(url)
 	if item != nil {
+		atomic.AddInt64(&c.hits, 1)
 		//fmt.Printf("HttpCache.get(): found url: %s, changeVector: %s, len(payload): %d\n", url, *item.changeVector, len(item.payload))
 		return newReleaseCacheItem(item), item.changeVector, item.payload
 	}
 
 	//fmt.Printf("HttpCache.get(): didn't find url: %s\n", url)
+	atomic.AddInt64(&c.misses, 1)
 	return newReleaseCacheItem(nil), nil, nil
 }
 
@@ -134,6 +248,7 @@ func newReleaseCacheItem(item *httpCacheItem) *releaseCacheItem {
 func (i *releaseCacheItem) notModified() {
 	if i.item != nil {
 		i.item.lastServerUpdate = time.Now()
+		atomic.AddInt64(&i.item.cache.notModified, 1)
 	}
 }
 
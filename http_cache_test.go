@@ -0,0 +1,85 @@
+package ravendb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHttpCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	// each item weighs 80 + 20 bytes so only 3 fit
+	cache := newHttpCache(300)
+	payload := make([]byte, 80)
+	cv := "A:1"
+
+	cache.set("a", &cv, payload)
+	cache.set("b", &cv, payload)
+	cache.set("c", &cv, payload)
+	assert.Equal(t, 3, cache.GetNumberOfItems())
+
+	// touch "a" so that "b" becomes least recently used
+	item, _, _ := cache.get("a")
+	assert.NotNil(t, item.item)
+
+	cache.set("d", &cv, payload)
+	assert.Equal(t, 3, cache.GetNumberOfItems())
+	item, _, _ = cache.get("b")
+	assert.Nil(t, item.item)
+	for _, url := range []string{"a", "c", "d"} {
+		item, _, _ = cache.get(url)
+		assert.NotNil(t, item.item, "url: %s", url)
+	}
+
+	// replacing an item doesn't count as eviction
+	cache.set("d", &cv, payload[:10])
+
+	// items bigger than the whole cache are not stored
+	cache.set("big", &cv, make([]byte, 1000))
+	item, _, _ = cache.get("big")
+	assert.Nil(t, item.item)
+
+	item, _, _ = cache.get("a")
+	item.notModified()
+
+	stats := cache.GetStats()
+	assert.Equal(t, 3, stats.NumberOfItems)
+	assert.Equal(t, int64(100+100+30), stats.SizeInBytes)
+	assert.Equal(t, int64(300), stats.MaxSizeInBytes)
+	assert.Equal(t, int64(1), stats.Evictions)
+	assert.Equal(t, int64(5), stats.Hits)
+	assert.Equal(t, int64(2), stats.Misses)
+	assert.Equal(t, int64(1), stats.NotModified)
+
+	cache.items.invalidateAll()
+	stats = cache.GetStats()
+	assert.Equal(t, 0, stats.NumberOfItems)
+	assert.Equal(t, int64(0), stats.SizeInBytes)
+}
+
+func TestSetMaxHttpCacheSize(t *testing.T) {
+	conventions := NewDocumentConventions()
+	assert.Error(t, conventions.SetMaxHttpCacheSize(-1))
+	assert.NoError(t, conventions.SetMaxHttpCacheSize(1024))
+	assert.Equal(t, 1024, conventions.getMaxHttpCacheSize())
+
+	conventions.Freeze()
+	err := conventions.SetMaxHttpCacheSize(2048)
+	_, ok := err.(*IllegalStateError)
+	assert.True(t, ok, "expected IllegalStateError, got %v", err)
+	assert.Equal(t, 1024, conventions.getMaxHttpCacheSize())
+}
+
+func TestHttpCacheStatsAfterClose(t *testing.T) {
+	cache := newHttpCache(300)
+	cv := "A:1"
+	cache.set("a", &cv, make([]byte, 80))
+	cache.get("a")
+	cache.get("b")
+
+	cache.close()
+	stats := cache.GetStats()
+	assert.Equal(t, 0, stats.NumberOfItems)
+	assert.Equal(t, int64(0), stats.SizeInBytes)
+	assert.Equal(t, int64(1), stats.Hits)
+	assert.Equal(t, int64(1), stats.Misses)
+}
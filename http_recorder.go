@@ -0,0 +1,313 @@
+package ravendb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const httpBodyEncodingBase64 = "base64"
+
+// HTTPRecordedBody is a body of a recorded request or response.
+// Text bodies (e.g. JSON) are stored as-is so that recordings
+// can be reviewed and diffed, binary bodies are base64-encoded
+type HTTPRecordedBody struct {
+	Body string `json:"Body,omitempty"`
+	// "base64" if Body is base64-encoded binary data
+	BodyEncoding string `json:"BodyEncoding,omitempty"`
+}
+
+func newHTTPRecordedBody(d []byte) HTTPRecordedBody {
+	if utf8.Valid(d) {
+		return HTTPRecordedBody{Body: string(d)}
+	}
+	return HTTPRecordedBody{
+		Body:         base64.StdEncoding.EncodeToString(d),
+		BodyEncoding: httpBodyEncodingBase64,
+	}
+}
+
+// Bytes returns the body as bytes
+func (b *HTTPRecordedBody) Bytes() ([]byte, error) {
+	if b.BodyEncoding == httpBodyEncodingBase64 {
+		return base64.StdEncoding.DecodeString(b.Body)
+	}
+	return []byte(b.Body), nil
+}
+
+// HTTPRecordedRequest describes a request sent by RequestExecutor
+type HTTPRecordedRequest struct {
+	Method string      `json:"Method"`
+	URL    string      `json:"Url"`
+	Header http.Header `json:"Header,omitempty"`
+	// body is stored decompressed if the request was compressed
+	HTTPRecordedBody
+}
+
+// HTTPRecordedResponse describes a response received from the server
+type HTTPRecordedResponse struct {
+	StatusCode int         `json:"StatusCode"`
+	Header     http.Header `json:"Header,omitempty"`
+	HTTPRecordedBody
+}
+
+// HTTPInteraction is a request and the response to it
+type HTTPInteraction struct {
+	Request  *HTTPRecordedRequest  `json:"Request"`
+	Response *HTTPRecordedResponse `json:"Response"`
+}
+
+// HTTPRecording is a content of a file written by HTTPRecorder.Save()
+type HTTPRecording struct {
+	Interactions []*HTTPInteraction `json:"Interactions"`
+}
+
+// HTTPRecorder records HTTP requests sent by RequestExecutor and
+// responses to them, which can later be served by HTTPReplayer without
+// access to the server. Set it in DocumentConventions.HTTPRecorder
+// before DocumentStore is initialized.
+//
+// Only requests of RavenCommands are recorded. Changes and subscriptions
+// use separate connections and are not recorded.
+type HTTPRecorder struct {
+	mu           sync.Mutex
+	interactions []*HTTPInteraction
+}
+
+// NewHTTPRecorder returns a new HTTPRecorder
+func NewHTTPRecorder() *HTTPRecorder {
+	return &HTTPRecorder{}
+}
+
+// Interactions returns interactions recorded so far
+func (r *HTTPRecorder) Interactions() []*HTTPInteraction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*HTTPInteraction(nil), r.interactions...)
+}
+
+// WriteTo writes recorded interactions as JSON
+func (r *HTTPRecorder) WriteTo(w io.Writer) (int64, error) {
+	recording := &HTTPRecording{
+		Interactions: r.Interactions(),
+	}
+	d, err := json.MarshalIndent(recording, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(d)
+	return int64(n), err
+}
+
+// Save writes recorded interactions to a file (golden file), which can be
+// loaded with LoadHTTPReplayer
+func (r *HTTPRecorder) Save(path string) error {
+	var buf bytes.Buffer
+	if _, err := r.WriteTo(&buf); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, buf.Bytes(), 0644)
+}
+
+func (r *HTTPRecorder) add(interaction *HTTPInteraction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.interactions = append(r.interactions, interaction)
+}
+
+// wrapTransport returns a transport that sends requests with next and
+// records them
+func (r *HTTPRecorder) wrapTransport(next http.RoundTripper) http.RoundTripper {
+	return &httpRecordingTransport{
+		recorder: r,
+		next:     next,
+	}
+}
+
+type httpRecordingTransport struct {
+	recorder *HTTPRecorder
+	next     http.RoundTripper
+}
+
+func (t *httpRecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		// the request is sent with the original body
+		req = req.Clone(req.Context())
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+		req.Body, _ = req.GetBody()
+		req.ContentLength = int64(len(body))
+	}
+	recordedBody, err := decompressRequestBody(req.Header, body)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	rspBody, err := ioutil.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = ioutil.NopCloser(bytes.NewReader(rspBody))
+
+	t.recorder.add(&HTTPInteraction{
+		Request: &HTTPRecordedRequest{
+			Method:           req.Method,
+			URL:              req.URL.String(),
+			Header:           req.Header.Clone(),
+			HTTPRecordedBody: newHTTPRecordedBody(recordedBody),
+		},
+		Response: &HTTPRecordedResponse{
+			StatusCode:       rsp.StatusCode,
+			Header:           rsp.Header.Clone(),
+			HTTPRecordedBody: newHTTPRecordedBody(rspBody),
+		},
+	})
+	return rsp, nil
+}
+
+func decompressRequestBody(header http.Header, body []byte) ([]byte, error) {
+	if len(body) == 0 || !strings.EqualFold(header.Get("Content-Encoding"), "gzip") {
+		return body, nil
+	}
+	r, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// HTTPReplayer serves responses to requests of RequestExecutor from
+// interactions recorded with HTTPRecorder, without network access.
+// Set it in DocumentConventions.HTTPReplayer before DocumentStore is
+// initialized.
+//
+// A request is matched with a recorded one by method and URL. If there are
+// several such interactions, they're served in the order of recording,
+// preferring ones with the same body. Once they're used up, the last one
+// is served again, which handles repeated requests like topology updates.
+type HTTPReplayer struct {
+	mu           sync.Mutex
+	interactions []*HTTPInteraction
+	used         []bool
+	// index of the interaction last served for a given method and URL
+	lastServed map[string]int
+}
+
+// NewHTTPReplayer returns HTTPReplayer that serves given interactions
+func NewHTTPReplayer(interactions []*HTTPInteraction) *HTTPReplayer {
+	return &HTTPReplayer{
+		interactions: interactions,
+		used:         make([]bool, len(interactions)),
+		lastServed:   map[string]int{},
+	}
+}
+
+// LoadHTTPReplayer returns HTTPReplayer that serves interactions from a file
+// written by HTTPRecorder.Save()
+func LoadHTTPReplayer(path string) (*HTTPReplayer, error) {
+	d, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var recording HTTPRecording
+	if err = json.Unmarshal(d, &recording); err != nil {
+		return nil, err
+	}
+	return NewHTTPReplayer(recording.Interactions), nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *HTTPReplayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if body, err = decompressRequestBody(req.Header, body); err != nil {
+			return nil, err
+		}
+	}
+
+	interaction := r.match(req.Method, req.URL.String(), body)
+	if interaction == nil {
+		return nil, newIllegalStateError("There is no recorded response for request %s %s", req.Method, req.URL.String())
+	}
+	recorded := interaction.Response
+	rspBody, err := recorded.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	rsp := &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recorded.Header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(rspBody)),
+		ContentLength: int64(len(rspBody)),
+		Request:       req,
+	}
+	if rsp.Header == nil {
+		rsp.Header = http.Header{}
+	}
+	return rsp, nil
+}
+
+func (r *HTTPReplayer) match(method string, uri string, body []byte) *HTTPInteraction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	found := -1
+	for i, interaction := range r.interactions {
+		req := interaction.Request
+		if r.used[i] || req.Method != method || req.URL != uri {
+			continue
+		}
+		recordedBody, err := req.Bytes()
+		if err == nil && bytes.Equal(recordedBody, body) {
+			found = i
+			break
+		}
+		if found == -1 {
+			found = i
+		}
+	}
+
+	key := method + " " + uri
+	if found == -1 {
+		last, ok := r.lastServed[key]
+		if !ok {
+			return nil
+		}
+		return r.interactions[last]
+	}
+	r.used[found] = true
+	r.lastServed[key] = found
+	return r.interactions[found]
+}
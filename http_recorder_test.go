@@ -0,0 +1,116 @@
+package ravendb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPRecordAndReplay(t *testing.T) {
+	nStatsRequests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/databases/db/docs":
+			_, _ = w.Write([]byte(`{"Results":[{"Name":"John","@metadata":{"@id":"users/1"}}],"Includes":{}}`))
+		case "/databases/db/stats":
+			nStatsRequests++
+			if nStatsRequests == 1 {
+				_, _ = w.Write([]byte(`{"CountOfDocuments": 5}`))
+			} else {
+				_, _ = w.Write([]byte(`{"CountOfDocuments": 6}`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	// run the same commands against the server and the recording
+	run := func(conventions *DocumentConventions) ([]int64, string, error) {
+		re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(srv.URL, "db", nil, nil, conventions)
+		defer re.Close()
+
+		var counts []int64
+		for i := 0; i < 2; i++ {
+			cmd := NewGetStatisticsCommand("")
+			if err := re.ExecuteCommand(cmd, nil); err != nil {
+				return nil, "", err
+			}
+			counts = append(counts, cmd.Result.CountOfDocuments)
+		}
+		cmd, err := NewGetDocumentsCommand([]string{"users/1"}, nil, false)
+		require.NoError(t, err)
+		if err = re.ExecuteCommand(cmd, nil); err != nil {
+			return nil, "", err
+		}
+		return counts, cmd.Result.Results[0]["Name"].(string), nil
+	}
+
+	recorder := NewHTTPRecorder()
+	conventions := NewDocumentConventions()
+	conventions.HTTPRecorder = recorder
+	counts, name, err := run(conventions)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5, 6}, counts)
+	assert.Equal(t, "John", name)
+	require.Len(t, recorder.Interactions(), 3)
+
+	path := filepath.Join(t.TempDir(), "recording.json")
+	require.NoError(t, recorder.Save(path))
+	srv.Close()
+
+	replayer, err := LoadHTTPReplayer(path)
+	require.NoError(t, err)
+	conventions = NewDocumentConventions()
+	conventions.HTTPReplayer = replayer
+	counts, name, err = run(conventions)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5, 6}, counts)
+	assert.Equal(t, "John", name)
+
+	// once used up, the last response is served again
+	counts, _, err = run(conventions)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{6, 6}, counts)
+
+	// requests that weren't recorded fail
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(srv.URL, "db", nil, nil, conventions)
+	defer re.Close()
+	cmd, err := NewGetDocumentsCommand([]string{"users/2"}, nil, false)
+	require.NoError(t, err)
+	err = re.ExecuteCommand(cmd, nil)
+	assert.Error(t, err)
+}
+
+func TestHTTPRecordCompressedRequest(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"Results":[]}`))
+	}))
+	defer srv.Close()
+
+	recorder := NewHTTPRecorder()
+	conventions := NewDocumentConventions()
+	conventions.Compression = CompressionAlgorithmGzip
+	conventions.HTTPRecorder = recorder
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(srv.URL, "db", nil, nil, conventions)
+	defer re.Close()
+
+	cmdData := NewDeleteCommandData("users/1", "")
+	cmd, err := newBatchCommand(conventions, []ICommandData{cmdData}, nil, TransactionMode_SingleNode, nil)
+	require.NoError(t, err)
+	require.NoError(t, re.ExecuteCommand(cmd, nil))
+
+	interactions := recorder.Interactions()
+	require.Len(t, interactions, 1)
+	req := interactions[0].Request
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.Equal(t, "gzip", req.Header.Get("Content-Encoding"))
+	// the body is stored decompressed
+	assert.Contains(t, req.Body, `"Id":"users/1"`)
+	assert.Equal(t, "", req.BodyEncoding)
+	assert.Equal(t, http.StatusCreated, interactions[0].Response.StatusCode)
+}
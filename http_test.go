@@ -0,0 +1,63 @@
+package ravendb
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readGzipBody(t *testing.T, req *http.Request) string {
+	r, err := gzip.NewReader(req.Body)
+	assert.NoError(t, err)
+	d, err := ioutil.ReadAll(r)
+	assert.NoError(t, err)
+	return string(d)
+}
+
+func TestCompressRequestBody(t *testing.T) {
+	body := `{"Commands":[]}`
+	{
+		req, err := NewHttpPost("http://localhost/bulk_docs", []byte(body))
+		assert.NoError(t, err)
+		err = compressRequestBody(req, CompressionAlgorithmNone)
+		assert.NoError(t, err)
+		assert.Equal(t, "", req.Header.Get("Content-Encoding"))
+		assert.Equal(t, int64(len(body)), req.ContentLength)
+	}
+
+	{
+		req, err := NewHttpPost("http://localhost/bulk_docs", []byte(body))
+		assert.NoError(t, err)
+		err = compressRequestBody(req, CompressionAlgorithmGzip)
+		assert.NoError(t, err)
+		assert.Equal(t, "gzip", req.Header.Get("Content-Encoding"))
+		assert.Equal(t, body, readGzipBody(t, req))
+
+		// the request can be re-played e.g. on failover
+		req.Body, err = req.GetBody()
+		assert.NoError(t, err)
+		assert.Equal(t, body, readGzipBody(t, req))
+	}
+
+	{
+		// streams are compressed as they are being read
+		req, err := newHttpPostReader("http://localhost/bulk_insert", strings.NewReader(body))
+		assert.NoError(t, err)
+		req.GetBody = nil
+		err = compressRequestBody(req, CompressionAlgorithmGzip)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-1), req.ContentLength)
+		assert.Equal(t, body, readGzipBody(t, req))
+	}
+
+	{
+		req, err := NewHttpPost("http://localhost/bulk_docs", []byte(body))
+		assert.NoError(t, err)
+		err = compressRequestBody(req, "Brotli")
+		assert.Error(t, err)
+	}
+}
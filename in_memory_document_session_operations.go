@@ -3,6 +3,7 @@ package ravendb
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"sync/atomic"
 	"time"
 )
@@ -55,6 +56,13 @@ type InMemoryDocumentSessionOperations struct {
 	// TODO: ignore case for keys
 	includedDocumentsByID map[string]*documentInfo
 
+	// values of counters known to the session, keyed by lower-cased document id
+	countersByDocID map[string]*countersCacheEntry
+
+	// ranges of time series known to the session, keyed by lower-cased
+	// document id and lower-cased time series name
+	timeSeriesByDocID map[string]map[string][]*TimeSeriesRangeResult
+
 	// hold the data required to manage the data for RavenDB's Unit of Work
 	// Note: in Java it's LinkedHashMap where iteration order is same
 	// as insertion order. In Go map has random iteration order so we must
@@ -104,6 +112,8 @@ func newInMemoryDocumentSessionOperations(dbName string, store *DocumentStore, r
 		sessionInfo:                   &SessionInfo{SessionID: clientSessionID},
 		documentsByID:                 newDocumentsByID(),
 		includedDocumentsByID:         map[string]*documentInfo{},
+		countersByDocID:               map[string]*countersCacheEntry{},
+		timeSeriesByDocID:             map[string]map[string][]*TimeSeriesRangeResult{},
 		documentsByEntity:             []*documentInfo{},
 		documentStore:                 store,
 		DatabaseName:                  dbName,
@@ -492,6 +502,27 @@ func (s *InMemoryDocumentSessionOperations) TrackEntity(result interface{}, id s
 	return nil
 }
 
+// setTypedQueryResult is like TrackEntity with noTracking for a result
+// of a query that was decoded directly into result (e.g. **Foo)
+func (s *InMemoryDocumentSessionOperations) setTypedQueryResult(result interface{}, id string, metadata map[string]interface{}) error {
+	if id == "" {
+		return nil
+	}
+	docInfo := s.documentsByID.getValue(id)
+	if docInfo == nil {
+		docInfo = s.includedDocumentsByID[id]
+	}
+	if docInfo != nil {
+		// the session already has this document, we return the same instance
+		return s.TrackEntity(result, id, docInfo.document, docInfo.metadata, true)
+	}
+	if jsonGetAsTextPointer(metadata, MetadataChangeVector) == nil {
+		return newIllegalStateError("Document %s must have Change Vector", id)
+	}
+	trySetIDOnEntity(result, id)
+	return nil
+}
+
 // will convert **Foo => *Foo if tp is *Foo and o is **Foo
 // TODO: probably there's a better way
 // Test case: TestCachingOfDocumentInclude.cofi_can_avoid_using_server_for_multiload_with_include_if_everything_is_in_session_cache
@@ -1113,6 +1144,10 @@ func (s *InMemoryDocumentSessionOperations) Evict(entity interface{}) error {
 	}
 
 	s.deletedEntities.remove(entity)
+	if deleted != nil {
+		delete(s.countersByDocID, strings.ToLower(deleted.id))
+		delete(s.timeSeriesByDocID, strings.ToLower(deleted.id))
+	}
 	return nil
 }
 
@@ -1123,6 +1158,8 @@ func (s *InMemoryDocumentSessionOperations) Clear() {
 	s.documentsByID = nil
 	s.knownMissingIds = nil
 	s.includedDocumentsByID = nil
+	s.countersByDocID = map[string]*countersCacheEntry{}
+	s.timeSeriesByDocID = map[string]map[string][]*TimeSeriesRangeResult{}
 }
 
 // Defer defers commands to be executed on SaveChanges()
@@ -1141,7 +1178,8 @@ func (s *InMemoryDocumentSessionOperations) deferInternal(command ICommandData)
 
 	cmdType := command.getType()
 	isAttachmentCmd := (cmdType == CommandAttachmentPut) || (cmdType == CommandAttachmentDelete)
-	if !isAttachmentCmd {
+	// counters and time series don't modify the document so they don't conflict with a PUT
+	if !isAttachmentCmd && cmdType != CommandCounters && cmdType != CommandTimeSeries {
 		idType = newIDTypeAndName(command.getId(), CommandClientNotAttachment, "")
 		s.deferredCommandsMap[idType] = command
 	}
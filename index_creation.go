@@ -1,16 +1,20 @@
 package ravendb
 
-func indexCreationCreateIndexesToAdd(indexCreationTasks []*IndexCreationTask, conventions *DocumentConventions) []*IndexDefinition {
+func indexCreationCreateIndexesToAdd(indexCreationTasks []IAbstractIndexCreationTask, conventions *DocumentConventions) []*IndexDefinition {
 	var res []*IndexDefinition
-	for _, x := range indexCreationTasks {
-		x.Conventions = conventions
-		definition := x.CreateIndexDefinition()
-		definition.Name = x.IndexName
-		pri := x.Priority
-		if pri == "" {
-			pri = IndexPriorityNormal
+	for _, task := range indexCreationTasks {
+		var definition *IndexDefinition
+		if x, ok := task.(*IndexCreationTask); ok {
+			x.Conventions = conventions
+			definition = x.CreateIndexDefinition()
+			definition.Priority = x.Priority
+		} else {
+			definition = task.CreateIndexDefinition()
+		}
+		definition.Name = task.GetIndexName()
+		if definition.Priority == "" {
+			definition.Priority = IndexPriorityNormal
 		}
-		definition.Priority = pri
 		res = append(res, definition)
 	}
 	return res
@@ -0,0 +1,202 @@
+package ravendb
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+var csharpIdentifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// NewIndexCreationTaskFromStruct returns a map index over documents of
+// type typ, derived from `ravendb` tags of its fields. Options of the tag are:
+//
+//	index             - index the field with default indexing
+//	search            - index the field for full-text search
+//	exact             - index the field for exact matching
+//	store             - store the field in the index
+//	analyzer=<name>   - analyze the field with a given analyzer
+//	termvector=<type> - store term vectors, <type> is one of FieldTermVector values
+//	suggestions       - index the field for suggestions
+//	spatial           - the field holds a WKT shape indexed as geography
+//
+// For example:
+//
+//	type Employee struct {
+//		ID        string
+//		FirstName string `ravendb:"index"`
+//		Notes     string `ravendb:"search,store,analyzer=StandardAnalyzer"`
+//	}
+//
+// produces index with map "from doc in docs.Employees select new { FirstName = doc.FirstName, Notes = doc.Notes }".
+// Collection name comes from conventions, which are optional.
+// The result can be deployed with DocumentStore.ExecuteIndex or DocumentStore.ExecuteIndexes
+func NewIndexCreationTaskFromStruct(indexName string, typ reflect.Type, conventions *DocumentConventions) (*IndexCreationTask, error) {
+	if indexName == "" {
+		return nil, newIllegalArgumentError("indexName cannot be empty")
+	}
+	if typ == nil {
+		return nil, newIllegalArgumentError("typ cannot be nil")
+	}
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return nil, newIllegalArgumentError("type %s is not a struct", typ)
+	}
+	if conventions == nil {
+		conventions = NewDocumentConventions()
+	}
+	collection := conventions.getCollectionName(typ)
+	if !csharpIdentifierRegex.MatchString(collection) {
+		return nil, newIllegalArgumentError("collection name '%s' of type %s is not a valid identifier", collection, typ)
+	}
+
+	task := NewIndexCreationTask(indexName)
+	task.Conventions = conventions
+	var selects []string
+	if err := addIndexFieldsFromStruct(task, typ, &selects); err != nil {
+		return nil, err
+	}
+	if len(selects) == 0 {
+		return nil, newIllegalArgumentError("type %s has no fields with ravendb index tags", typ)
+	}
+	task.Map = "from doc in docs." + collection + " select new { " + strings.Join(selects, ", ") + " }"
+	return task, nil
+}
+
+func addIndexFieldsFromStruct(task *IndexCreationTask, typ reflect.Type, selects *[]string) error {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		embedded := field.Type
+		if embedded.Kind() == reflect.Ptr {
+			embedded = embedded.Elem()
+		}
+		isEmbeddedStruct := field.Anonymous && embedded.Kind() == reflect.Struct
+		if isEmbeddedStruct && !hasJSONName(field) && field.Tag.Get("json") != "-" {
+			// like in encoding/json, fields of embedded structs without
+			// json name are serialized as fields of the outer struct
+			if err := addIndexFieldsFromStruct(task, embedded, selects); err != nil {
+				return err
+			}
+			continue
+		}
+		if field.PkgPath != "" && !isEmbeddedStruct {
+			// unexported
+			continue
+		}
+		opts := parseRavenTag(field)
+		if !hasIndexTagOptions(opts) {
+			continue
+		}
+		name := getIndexFieldName(field)
+		if name == "" {
+			return newIllegalArgumentError("field %s.%s is not serialized to JSON and cannot be indexed", typ.Name(), field.Name)
+		}
+		if !csharpIdentifierRegex.MatchString(name) {
+			return newIllegalArgumentError("name '%s' of field %s.%s is not a valid identifier", name, typ.Name(), field.Name)
+		}
+		if err := applyIndexFieldOptions(task, typ, field, name, opts); err != nil {
+			return err
+		}
+		value := "doc." + name
+		if _, ok := opts["spatial"]; ok {
+			value = "CreateSpatialField(" + value + ")"
+		}
+		*selects = append(*selects, name+" = "+value)
+	}
+	return nil
+}
+
+var indexTagOptions = []string{"index", "search", "exact", "store", "analyzer", "termvector", "suggestions", "spatial"}
+
+func hasIndexTagOptions(opts map[string]string) bool {
+	for _, opt := range indexTagOptions {
+		if _, ok := opts[opt]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// applyIndexFieldOptions registers options from ravendb tag with the task
+func applyIndexFieldOptions(task *IndexCreationTask, typ reflect.Type, field reflect.StructField, name string, opts map[string]string) error {
+	_, search := opts["search"]
+	_, exact := opts["exact"]
+	if search && exact {
+		return newIllegalArgumentError("field %s.%s cannot be indexed with both search and exact", typ.Name(), field.Name)
+	}
+	if search {
+		task.Index(name, FieldIndexingSearch)
+	}
+	if exact {
+		task.Index(name, FieldIndexingExact)
+	}
+	if _, ok := opts["store"]; ok {
+		task.Store(name, FieldStorageYes)
+	}
+	if analyzer, ok := opts["analyzer"]; ok {
+		if analyzer == "" {
+			return newIllegalArgumentError("analyzer of field %s.%s cannot be empty", typ.Name(), field.Name)
+		}
+		task.Analyze(name, analyzer)
+	}
+	if termVector, ok := opts["termvector"]; ok {
+		tv, ok := parseFieldTermVector(termVector)
+		if !ok {
+			return newIllegalArgumentError("invalid termvector '%s' of field %s.%s", termVector, typ.Name(), field.Name)
+		}
+		task.TermVector(name, tv)
+	}
+	if _, ok := opts["suggestions"]; ok {
+		task.Suggestion(name)
+	}
+	if _, ok := opts["spatial"]; ok {
+		if field.Type.Kind() != reflect.String {
+			return newIllegalArgumentError("spatial field %s.%s must be a string with WKT shape", typ.Name(), field.Name)
+		}
+		task.Spatial(name, NewGeographyDefaultOptions)
+	}
+	return nil
+}
+
+// getIndexFieldName returns name of the field in the serialized document
+// or "" if the field is not serialized
+func getIndexFieldName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	if idx := strings.IndexByte(tag, ','); idx >= 0 {
+		tag = tag[:idx]
+	}
+	if tag == "" {
+		return field.Name
+	}
+	return tag
+}
+
+// hasJSONName returns true if json tag of the field sets its name
+func hasJSONName(field reflect.StructField) bool {
+	tag := field.Tag.Get("json")
+	if idx := strings.IndexByte(tag, ','); idx >= 0 {
+		tag = tag[:idx]
+	}
+	return tag != ""
+}
+
+func parseFieldTermVector(s string) (FieldTermVector, bool) {
+	all := []FieldTermVector{
+		FieldTermVectorNo,
+		FieldTermVectorYes,
+		FieldTermVectorWithPositions,
+		FieldTermVectorWithOffsets,
+		FieldTermVectorWithPositionsAndOffsets,
+	}
+	for _, tv := range all {
+		if strings.EqualFold(s, tv) {
+			return tv, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,97 @@
+package ravendb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type indexedPerson struct {
+	Name string `json:"name" ravendb:"index"`
+}
+
+type indexedEmployee struct {
+	indexedPerson
+	ID        string
+	Title     string  `ravendb:"exact,store"`
+	Notes     string  `json:"notes,omitempty" ravendb:"search,analyzer=StandardAnalyzer,termvector=withpositionsandoffsets"`
+	Location  string  `ravendb:"spatial"`
+	FirstName string  `ravendb:"suggestions"`
+	Salary    float64 `ravendb:"tsvalue=0"`
+	Manager   string
+}
+
+func TestNewIndexCreationTaskFromStruct(t *testing.T) {
+	task, err := NewIndexCreationTaskFromStruct("Employees/ByTags", reflect.TypeOf(&indexedEmployee{}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "from doc in docs.indexedEmployees select new { name = doc.name, Title = doc.Title, notes = doc.notes, Location = CreateSpatialField(doc.Location), FirstName = doc.FirstName }", task.Map)
+
+	def := task.CreateIndexDefinition()
+	assert.Equal(t, IndexTypeMap, def.GetType())
+	assert.Equal(t, []string{task.Map}, def.Maps)
+	assert.Equal(t, 4, len(def.Fields))
+	assert.Equal(t, FieldIndexing(FieldIndexingExact), def.Fields["Title"].Indexing)
+	assert.Equal(t, FieldStorageYes, def.Fields["Title"].Storage)
+	assert.Equal(t, FieldIndexing(FieldIndexingSearch), def.Fields["notes"].Indexing)
+	assert.Equal(t, "StandardAnalyzer", def.Fields["notes"].Analyzer)
+	assert.Equal(t, FieldTermVectorWithPositionsAndOffsets, def.Fields["notes"].TermVector)
+	assert.Equal(t, NewGeographyDefaultOptions(), def.Fields["Location"].Spatial)
+	assert.True(t, def.Fields["FirstName"].Suggestions)
+	assert.Nil(t, def.Fields["name"])
+
+	conventions := NewDocumentConventions()
+	conventions.FindCollectionName = func(interface{}) string {
+		return "Staff"
+	}
+	task, err = NewIndexCreationTaskFromStruct("Staff/ByName", reflect.TypeOf(indexedPerson{}), conventions)
+	require.NoError(t, err)
+	assert.Equal(t, "from doc in docs.Staff select new { name = doc.name }", task.Map)
+
+	// embedded struct with json name is serialized as a nested object
+	type namedEmbedded struct {
+		indexedPerson `json:"person" ravendb:"index"`
+		ID            string
+	}
+	task, err = NewIndexCreationTaskFromStruct("Staff/ByPerson", reflect.TypeOf(namedEmbedded{}), conventions)
+	require.NoError(t, err)
+	assert.Equal(t, "from doc in docs.Staff select new { person = doc.person }", task.Map)
+	type ignoredEmbedded struct {
+		indexedPerson `json:"-"`
+		ID            string
+	}
+	_, err = NewIndexCreationTaskFromStruct("Staff/ByName", reflect.TypeOf(ignoredEmbedded{}), conventions)
+	assert.Error(t, err)
+}
+
+func TestNewIndexCreationTaskFromStructErrors(t *testing.T) {
+	tests := []interface{}{
+		"not a struct",
+		struct{ Name string }{},
+		struct {
+			Name string `ravendb:"search,exact"`
+		}{},
+		struct {
+			Name string `ravendb:"termvector=sometimes"`
+		}{},
+		struct {
+			Name string `ravendb:"analyzer"`
+		}{},
+		struct {
+			Location float64 `ravendb:"spatial"`
+		}{},
+		struct {
+			Name string `json:"-" ravendb:"index"`
+		}{},
+		struct {
+			Name string `json:"first-name" ravendb:"index"`
+		}{},
+	}
+	for _, v := range tests {
+		_, err := NewIndexCreationTaskFromStruct("Index", reflect.TypeOf(v), nil)
+		assert.Error(t, err, "type %T", v)
+	}
+	_, err := NewIndexCreationTaskFromStruct("", reflect.TypeOf(indexedPerson{}), nil)
+	assert.Error(t, err)
+}
@@ -1,5 +1,12 @@
 package ravendb
 
+import (
+	"regexp"
+	"strings"
+)
+
+var indexDefinitionCommentsRegex = regexp.MustCompile(`(?s)/\*.*?\*/|//[^\n]*`)
+
 type IndexDefinition struct {
 	Name              string                        `json:"Name"`
 	Priority          IndexPriority                 `json:"Priority,omitempty"`
@@ -101,12 +108,29 @@ func (d *IndexDefinition) SetType(indexType IndexType) {
 }
 
 func (d *IndexDefinition) detectStaticIndexType() IndexType {
-	if d.Reduce == nil || stringIsBlank(*d.Reduce) {
+	isMap := d.Reduce == nil || stringIsBlank(*d.Reduce)
+	if len(d.Maps) > 0 {
+		// C# maps start with "from" or "docs", anything else is JavaScript
+		firstMap := strings.TrimSpace(indexDefinitionCommentsRegex.ReplaceAllString(d.Maps[0], ""))
+		if !strings.HasPrefix(firstMap, "from") && !strings.HasPrefix(firstMap, "docs") {
+			if isMap {
+				return IndexTypeJavaScriptMap
+			}
+			return IndexTypeJavaScriptMapReduce
+		}
+	}
+	if isMap {
 		return IndexTypeMap
 	}
 	return IndexTypeMapReduce
 }
 
+// IsJavaScript returns true if this is a JavaScript index
+func (d *IndexDefinition) IsJavaScript() bool {
+	t := d.GetType()
+	return t == IndexTypeJavaScriptMap || t == IndexTypeJavaScriptMapReduce
+}
+
 //TBD 4.1  bool isTestIndex()
 
 //TBD 4.1   setTestIndex(bool testIndex)
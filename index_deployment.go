@@ -0,0 +1,327 @@
+package ravendb
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// how often DeployIndexes checks if side-by-side indexes replaced indexes
+const indexReplacementPollInterval = time.Millisecond * 100
+
+// IndexDeploymentAction describes what DocumentStore.DeployIndexes does with an index
+type IndexDeploymentAction = string
+
+const (
+	IndexDeploymentActionCreate = "Create"
+	IndexDeploymentActionUpdate = "Update"
+	IndexDeploymentActionNone   = "None"
+	IndexDeploymentActionDelete = "Delete"
+)
+
+// IndexDeploymentOptions are options for DocumentStore.DeployIndexes
+type IndexDeploymentOptions struct {
+	// DryRun only reports changes without deploying them
+	DryRun bool
+	// DeleteUnusedIndexes deletes static indexes that exist on the server
+	// but are not among deployed indexes. Auto indexes are never deleted
+	DeleteUnusedIndexes bool
+	// WaitForReplacement waits until side-by-side indexes of updated
+	// indexes catch up and replace the original indexes
+	WaitForReplacement bool
+	// WaitTimeout is how long to wait for replacement. Default is 1 minute.
+	// When it passes, DeployIndexes returns TimeoutError
+	WaitTimeout time.Duration
+}
+
+// IndexDeploymentChange describes a change to a single index
+type IndexDeploymentChange struct {
+	IndexName string
+	Action    IndexDeploymentAction
+	// Differences are names of properties of IndexDefinition (e.g. "Maps", "Fields")
+	// that differ between local and server definition of an updated index
+	Differences []string
+}
+
+// IndexDeploymentResult is a result of DocumentStore.DeployIndexes
+type IndexDeploymentResult struct {
+	Changes []*IndexDeploymentChange
+}
+
+// HasChanges returns true if any index was created, updated or deleted
+func (r *IndexDeploymentResult) HasChanges() bool {
+	for _, change := range r.Changes {
+		if change.Action != IndexDeploymentActionNone {
+			return true
+		}
+	}
+	return false
+}
+
+// IndexNames returns names of indexes with a given action
+func (r *IndexDeploymentResult) IndexNames(action IndexDeploymentAction) []string {
+	var res []string
+	for _, change := range r.Changes {
+		if change.Action == action {
+			res = append(res, change.IndexName)
+		}
+	}
+	return res
+}
+
+// String returns a human-readable summary of changes, one index per line
+func (r *IndexDeploymentResult) String() string {
+	var lines []string
+	for _, change := range r.Changes {
+		line := change.Action + " " + change.IndexName
+		if len(change.Differences) > 0 {
+			line += " (" + strings.Join(change.Differences, ", ") + ")"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func createIndexDefinitionForDeployment(task IAbstractIndexCreationTask, conventions *DocumentConventions) *IndexDefinition {
+	var definition *IndexDefinition
+	if t, ok := task.(*IndexCreationTask); ok {
+		// like putIndex
+		oldConventions := t.Conventions
+		defer func() { t.Conventions = oldConventions }()
+		t.Conventions = conventions
+		definition = t.CreateIndexDefinition()
+		definition.LockMode = t.LockMode
+		definition.Priority = t.Priority
+	} else {
+		definition = task.CreateIndexDefinition()
+	}
+	definition.Name = task.GetIndexName()
+	if definition.Priority == "" {
+		definition.Priority = IndexPriorityNormal
+	}
+	return definition
+}
+
+func deployIndexes(ctx context.Context, store *DocumentStore, tasks []IAbstractIndexCreationTask, database string, options *IndexDeploymentOptions) (*IndexDeploymentResult, error) {
+	if options == nil {
+		options = &IndexDeploymentOptions{}
+	}
+	maintenance := store.Maintenance().ForDatabase(database)
+
+	namesOp := NewGetIndexNamesOperation(0, math.MaxInt32)
+	if err := maintenance.SendCtx(ctx, namesOp); err != nil {
+		return nil, err
+	}
+	existing := map[string]bool{}
+	for _, name := range namesOp.Command.Result {
+		existing[name] = true
+	}
+
+	result := &IndexDeploymentResult{}
+	deployed := map[string]bool{}
+	var toPut []*IndexDefinition
+	for _, task := range tasks {
+		definition := createIndexDefinitionForDeployment(task, store.GetConventions())
+		if deployed[definition.Name] {
+			return nil, newIllegalArgumentError("index '%s' is defined more than once", definition.Name)
+		}
+		deployed[definition.Name] = true
+
+		change := &IndexDeploymentChange{
+			IndexName: definition.Name,
+			Action:    IndexDeploymentActionCreate,
+		}
+		if existing[definition.Name] {
+			hasChangedOp := NewIndexHasChangedOperation(definition)
+			if err := maintenance.SendCtx(ctx, hasChangedOp); err != nil {
+				return nil, err
+			}
+			change.Action = IndexDeploymentActionNone
+			if hasChangedOp.Command.Result {
+				change.Action = IndexDeploymentActionUpdate
+				getOp := NewGetIndexOperation(definition.Name)
+				if err := maintenance.SendCtx(ctx, getOp); err != nil {
+					return nil, err
+				}
+				change.Differences = indexDefinitionDifferences(definition, getOp.Command.Result)
+			}
+		}
+		if change.Action != IndexDeploymentActionNone {
+			toPut = append(toPut, definition)
+		}
+		result.Changes = append(result.Changes, change)
+	}
+
+	var toDelete []string
+	if options.DeleteUnusedIndexes {
+		for _, name := range namesOp.Command.Result {
+			if deployed[name] || strings.HasPrefix(name, "Auto/") || strings.HasPrefix(name, IndexingSideBySideIndexNamePrefix) {
+				continue
+			}
+			toDelete = append(toDelete, name)
+			result.Changes = append(result.Changes, &IndexDeploymentChange{
+				IndexName: name,
+				Action:    IndexDeploymentActionDelete,
+			})
+		}
+	}
+
+	if options.DryRun {
+		return result, nil
+	}
+
+	if len(toPut) > 0 {
+		if err := maintenance.SendCtx(ctx, NewPutIndexesOperation(toPut...)); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range toDelete {
+		if err := maintenance.SendCtx(ctx, NewDeleteIndexOperation(name)); err != nil {
+			return nil, err
+		}
+	}
+
+	if options.WaitForReplacement {
+		updated := result.IndexNames(IndexDeploymentActionUpdate)
+		if err := waitForIndexReplacement(ctx, maintenance, updated, options.WaitTimeout); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// waitForIndexReplacement waits until side-by-side indexes of a given
+// indexes no longer exist i.e. they replaced the original indexes
+func waitForIndexReplacement(ctx context.Context, maintenance *MaintenanceOperationExecutor, indexNames []string, timeout time.Duration) error {
+	if len(indexNames) == 0 {
+		return nil
+	}
+	if timeout == 0 {
+		timeout = time.Minute
+	}
+	replacements := map[string]bool{}
+	for _, name := range indexNames {
+		replacements[IndexingSideBySideIndexNamePrefix+name] = true
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		op := NewGetIndexesStatisticsOperation()
+		if err := maintenance.SendCtx(ctx, op); err != nil {
+			return err
+		}
+		isDone := true
+		for _, stats := range op.Command.Result {
+			if !replacements[stats.Name] {
+				continue
+			}
+			if stats.State == IndexStateError {
+				return newIllegalStateError("index '%s' is in error state", stats.Name)
+			}
+			isDone = false
+		}
+		if isDone {
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return NewTimeoutError("side-by-side indexes didn't replace indexes %s in %s", strings.Join(indexNames, ", "), timeout)
+		}
+		if remaining > indexReplacementPollInterval {
+			remaining = indexReplacementPollInterval
+		}
+		timer := time.NewTimer(remaining)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// indexDefinitionDifferences returns names of properties that differ
+// between local and remote definition of an index
+func indexDefinitionDifferences(local *IndexDefinition, remote *IndexDefinition) []string {
+	var res []string
+	if !stringSetsEqual(local.Maps, remote.Maps) {
+		res = append(res, "Maps")
+	}
+	if strings.TrimSpace(stringPtrOrEmpty(local.Reduce)) != strings.TrimSpace(stringPtrOrEmpty(remote.Reduce)) {
+		res = append(res, "Reduce")
+	}
+	if !indexFieldsEqual(local.Fields, remote.Fields) {
+		res = append(res, "Fields")
+	}
+	if !stringMapsEqual(local.Configuration, remote.Configuration) {
+		res = append(res, "Configuration")
+	}
+	if !stringMapsEqual(local.AdditionalSources, remote.AdditionalSources) {
+		res = append(res, "AdditionalSources")
+	}
+	if stringPtrOrEmpty(local.OutputReduceToCollection) != stringPtrOrEmpty(remote.OutputReduceToCollection) {
+		res = append(res, "OutputReduceToCollection")
+	}
+	if local.LockMode != "" && local.LockMode != remote.LockMode {
+		res = append(res, "LockMode")
+	}
+	if local.Priority != "" && local.Priority != remote.Priority {
+		res = append(res, "Priority")
+	}
+	return res
+}
+
+func stringPtrOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func stringSetsEqual(a []string, b []string) bool {
+	normalize := func(a []string) []string {
+		res := make([]string, len(a))
+		for i, s := range a {
+			res[i] = strings.TrimSpace(s)
+		}
+		// also sorts
+		return stringArrayRemoveDuplicates(res)
+	}
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+func stringMapsEqual(a map[string]string, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if v2, ok := b[k]; !ok || v != v2 {
+			return false
+		}
+	}
+	return true
+}
+
+func indexFieldsEqual(a map[string]*IndexFieldOptions, b map[string]*IndexFieldOptions) bool {
+	// fields with default options are the same as missing fields
+	get := func(m map[string]*IndexFieldOptions, name string) *IndexFieldOptions {
+		if options := m[name]; options != nil {
+			return options
+		}
+		return NewIndexFieldOptions()
+	}
+	for name := range a {
+		if !reflect.DeepEqual(get(a, name), get(b, name)) {
+			return false
+		}
+	}
+	for name := range b {
+		if !reflect.DeepEqual(get(a, name), get(b, name)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,200 @@
+package ravendb
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeIndexServer keeps index definitions in memory and serves endpoints
+// used by DocumentStore.DeployIndexes
+type fakeIndexServer struct {
+	t  *testing.T
+	mu sync.Mutex
+
+	definitions map[string]*IndexDefinition
+	// number of stats requests after which side-by-side index disappears
+	replaceAfter int
+	statsCalls   int
+	puts         []string
+	deletes      []string
+}
+
+func (s *fakeIndexServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeJSON := func(v interface{}) {
+		d, err := json.Marshal(v)
+		assert.NoError(s.t, err)
+		_, _ = w.Write(d)
+	}
+	readDefinitions := func() []*IndexDefinition {
+		d, _ := ioutil.ReadAll(r.Body)
+		var res struct {
+			Indexes []*IndexDefinition `json:"Indexes"`
+		}
+		assert.NoError(s.t, json.Unmarshal(d, &res))
+		return res.Indexes
+	}
+
+	switch {
+	case r.URL.Path == "/databases/db/indexes" && r.Method == http.MethodGet && r.URL.Query().Get("namesOnly") == "true":
+		var names []string
+		for name := range s.definitions {
+			names = append(names, name)
+		}
+		writeJSON(map[string]interface{}{"Results": stringArrayRemoveDuplicates(names)})
+	case r.URL.Path == "/databases/db/indexes" && r.Method == http.MethodGet:
+		writeJSON(map[string]interface{}{"Results": []*IndexDefinition{s.definitions[r.URL.Query().Get("name")]}})
+	case r.URL.Path == "/databases/db/indexes" && r.Method == http.MethodDelete:
+		name := r.URL.Query().Get("name")
+		s.deletes = append(s.deletes, name)
+		delete(s.definitions, name)
+	case r.URL.Path == "/databases/db/indexes/has-changed":
+		var definition *IndexDefinition
+		d, _ := ioutil.ReadAll(r.Body)
+		assert.NoError(s.t, json.Unmarshal(d, &definition))
+		existing := s.definitions[definition.Name]
+		changed := existing == nil || len(indexDefinitionDifferences(definition, existing)) > 0
+		writeJSON(map[string]interface{}{"Changed": changed})
+	case r.URL.Path == "/databases/db/admin/indexes":
+		var results []map[string]interface{}
+		for _, definition := range readDefinitions() {
+			s.puts = append(s.puts, definition.Name)
+			if _, ok := s.definitions[definition.Name]; ok {
+				s.definitions[IndexingSideBySideIndexNamePrefix+definition.Name] = definition
+			} else {
+				s.definitions[definition.Name] = definition
+			}
+			results = append(results, map[string]interface{}{"Index": definition.Name, "RaftCommandIndex": 1})
+		}
+		writeJSON(map[string]interface{}{"Results": results})
+	case r.URL.Path == "/databases/db/indexes/stats":
+		s.statsCalls++
+		var results []*IndexStats
+		for name, definition := range s.definitions {
+			if strings.HasPrefix(name, IndexingSideBySideIndexNamePrefix) && s.statsCalls >= s.replaceAfter {
+				delete(s.definitions, name)
+				s.definitions[definition.Name] = definition
+				continue
+			}
+			results = append(results, &IndexStats{Name: name, State: IndexStateNormal})
+		}
+		writeJSON(map[string]interface{}{"Results": results})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newIndexDeploymentTestTask(name string, smap string) *IndexCreationTask {
+	task := NewIndexCreationTask(name)
+	task.Map = smap
+	return task
+}
+
+func TestDeployIndexes(t *testing.T) {
+	server := &fakeIndexServer{
+		t:            t,
+		definitions:  map[string]*IndexDefinition{},
+		replaceAfter: 3,
+	}
+	srv := httptest.NewServer(server)
+	defer srv.Close()
+
+	store := NewDocumentStore([]string{srv.URL}, "db")
+	store.GetConventions().SetDisableTopologyUpdates(true)
+	require.NoError(t, store.Initialize())
+	defer store.Close()
+
+	byName := newIndexDeploymentTestTask("Users/ByName", "from u in docs.Users select new { u.Name }")
+	byAge := newIndexDeploymentTestTask("Users/ByAge", "from u in docs.Users select new { u.Age }")
+	legacy := newIndexDeploymentTestTask("Users/Legacy", "from u in docs.Users select new { u.Email }")
+	server.definitions["Users/Legacy"] = createIndexDefinitionForDeployment(legacy, store.GetConventions())
+	server.definitions["Auto/Users/ByEmail"] = &IndexDefinition{Name: "Auto/Users/ByEmail"}
+
+	tasks := []IAbstractIndexCreationTask{byName, byAge}
+	result, err := store.DeployIndexes(tasks, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Users/ByName", "Users/ByAge"}, result.IndexNames(IndexDeploymentActionCreate))
+	assert.Equal(t, []string{"Users/ByName", "Users/ByAge"}, server.puts)
+
+	// nothing changed, nothing is deployed
+	server.puts = nil
+	result, err = store.DeployIndexes(tasks, "", nil)
+	require.NoError(t, err)
+	assert.False(t, result.HasChanges())
+	assert.Nil(t, server.puts)
+
+	// changed index is deployed side-by-side and unused static index is deleted
+	byAge.Map = "from u in docs.Users select new { u.Age, u.Name }"
+	byAge.Index("Name", FieldIndexingSearch)
+	options := &IndexDeploymentOptions{
+		DeleteUnusedIndexes: true,
+		WaitForReplacement:  true,
+	}
+	result, err = store.DeployIndexes(tasks, "", options)
+	require.NoError(t, err)
+	assert.Equal(t, "None Users/ByName\nUpdate Users/ByAge (Maps, Fields)\nDelete Users/Legacy", result.String())
+	assert.Equal(t, []string{"Users/ByAge"}, server.puts)
+	assert.Equal(t, []string{"Users/Legacy"}, server.deletes)
+	assert.True(t, server.statsCalls >= server.replaceAfter)
+	assert.Nil(t, server.definitions[IndexingSideBySideIndexNamePrefix+"Users/ByAge"])
+	assert.NotNil(t, server.definitions["Auto/Users/ByEmail"])
+
+	// dry run only reports changes
+	server.puts = nil
+	byName.Reduce = "from r in results group r by r.Name into g select new { Name = g.Key }"
+	result, err = store.DeployIndexes(tasks, "", &IndexDeploymentOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Users/ByName"}, result.IndexNames(IndexDeploymentActionUpdate))
+	assert.Nil(t, server.puts)
+
+	_, err = store.DeployIndexes([]IAbstractIndexCreationTask{byName, byName}, "", nil)
+	assert.Error(t, err)
+	// conventions of the store are used only while creating definitions
+	assert.Nil(t, byName.Conventions)
+}
+
+func TestDeployIndexesStopsWaitingForReplacement(t *testing.T) {
+	byName := newIndexDeploymentTestTask("Users/ByName", "from u in docs.Users select new { u.Name }")
+	server := &fakeIndexServer{
+		t:            t,
+		definitions:  map[string]*IndexDefinition{},
+		replaceAfter: math.MaxInt32,
+	}
+	srv := httptest.NewServer(server)
+	defer srv.Close()
+
+	store := NewDocumentStore([]string{srv.URL}, "db")
+	store.GetConventions().SetDisableTopologyUpdates(true)
+	require.NoError(t, store.Initialize())
+	defer store.Close()
+	server.definitions["Users/ByName"] = &IndexDefinition{Name: "Users/ByName", Maps: []string{"from u in docs.Users select new { u.Age }"}}
+
+	tasks := []IAbstractIndexCreationTask{byName}
+	options := &IndexDeploymentOptions{
+		WaitForReplacement: true,
+		WaitTimeout:        time.Millisecond * 300,
+	}
+	_, err := store.DeployIndexes(tasks, "", options)
+	_, ok := err.(*TimeoutError)
+	assert.True(t, ok, "expected TimeoutError, got %v", err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*300)
+	defer cancel()
+	options.WaitTimeout = time.Minute
+	start := time.Now()
+	_, err = store.DeployIndexesCtx(ctx, tasks, "", options)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(start) < time.Second*5, "took %s", time.Since(start))
+}
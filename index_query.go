@@ -46,8 +46,8 @@ func (q *IndexQuery) GetQueryHash() string {
 	hasher.write(q.query)
 	hasher.write(q.waitForNonStaleResults)
 	hasher.write(q.skipDuplicateChecking)
-	//TBD 4.1 hasher.write(isShowTimings());
-	//TBD 4.1 hasher.write(isExplainScores());
+	// timings and explanations are requested with "include" in the query
+	// text so they're already part of the hash
 	n := int64(q.waitForNonStaleResultsTimeout)
 	hasher.write(n)
 	hasher.write(q.start)
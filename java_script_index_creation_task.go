@@ -0,0 +1,140 @@
+package ravendb
+
+var (
+	_ IAbstractIndexCreationTask = &JavaScriptIndexCreationTask{}
+)
+
+// JavaScriptIndexCreationTask is for creating IndexDefinition of an index
+// written in JavaScript. For example:
+//
+//	task := NewJavaScriptIndexCreationTask("Users/ByName")
+//	task.Maps = []string{"map('Users', u => ({ Name: u.Name }))"}
+//	task.Index("Name", FieldIndexingSearch)
+//	err := store.ExecuteIndex(task, "")
+type JavaScriptIndexCreationTask struct {
+	// Maps are JavaScript map functions e.g. "map('Users', u => ({ Name: u.Name }))"
+	Maps []string
+	// Reduce is JavaScript reduce function e.g.
+	// "groupBy(x => x.Name).aggregate(g => ({ Name: g.key, Count: g.values.length }))"
+	Reduce string
+
+	// AdditionalSources maps names of additional sources to JavaScript
+	// code that can be used in Maps and Reduce
+	AdditionalSources map[string]string
+	Configuration     IndexConfiguration
+	Fields            map[string]*IndexFieldOptions
+	Priority          IndexPriority
+	LockMode          IndexLockMode
+
+	OutputReduceToCollection string
+
+	IndexName string
+}
+
+// NewJavaScriptIndexCreationTask returns new JavaScriptIndexCreationTask
+func NewJavaScriptIndexCreationTask(indexName string) *JavaScriptIndexCreationTask {
+	panicIf(indexName == "", "indexName cannot be empty")
+	return &JavaScriptIndexCreationTask{
+		AdditionalSources: make(map[string]string),
+		Configuration:     NewIndexConfiguration(),
+		Fields:            make(map[string]*IndexFieldOptions),
+
+		IndexName: indexName,
+	}
+}
+
+// GetIndexName returns the name of the index
+func (t *JavaScriptIndexCreationTask) GetIndexName() string {
+	return t.IndexName
+}
+
+// IsMapReduce returns true if this is map-reduce index
+func (t *JavaScriptIndexCreationTask) IsMapReduce() bool {
+	return t.Reduce != ""
+}
+
+// CreateIndexDefinition creates IndexDefinition
+func (t *JavaScriptIndexCreationTask) CreateIndexDefinition() *IndexDefinition {
+	def := NewIndexDefinition()
+	def.Name = t.IndexName
+	def.Priority = t.Priority
+	def.LockMode = t.LockMode
+	def.Maps = stringArrayRemoveDuplicates(append([]string{}, t.Maps...))
+	if t.IsMapReduce() {
+		reduce := t.Reduce
+		def.Reduce = &reduce
+		def.SetType(IndexTypeJavaScriptMapReduce)
+	} else {
+		def.SetType(IndexTypeJavaScriptMap)
+	}
+	def.SetOutputReduceToCollection(t.OutputReduceToCollection)
+	for name, source := range t.AdditionalSources {
+		def.GetAdditionalSources()[name] = source
+	}
+	for name, value := range t.Configuration {
+		def.GetConfiguration()[name] = value
+	}
+	for name, options := range t.Fields {
+		def.GetFields()[name] = options
+	}
+	return def
+}
+
+// Execute executes index in specified document store
+func (t *JavaScriptIndexCreationTask) Execute(store *DocumentStore, conventions *DocumentConventions, database string) error {
+	if len(t.Maps) == 0 {
+		return newIllegalStateError("Maps are required to generate an index, you cannot create an index without a valid map (in index %s)", t.IndexName)
+	}
+	if database == "" {
+		database = store.GetDatabase()
+	}
+	op := NewPutIndexesOperation(t.CreateIndexDefinition())
+	return store.Maintenance().ForDatabase(database).Send(op)
+}
+
+func (t *JavaScriptIndexCreationTask) field(name string) *IndexFieldOptions {
+	if t.Fields == nil {
+		t.Fields = make(map[string]*IndexFieldOptions)
+	}
+	options, ok := t.Fields[name]
+	if !ok {
+		options = NewIndexFieldOptions()
+		t.Fields[name] = options
+	}
+	return options
+}
+
+// Index registers field to be indexed
+func (t *JavaScriptIndexCreationTask) Index(field string, indexing FieldIndexing) {
+	t.field(field).Indexing = indexing
+}
+
+// Spatial registers field to be spatially indexed
+func (t *JavaScriptIndexCreationTask) Spatial(field string, indexing func() *SpatialOptions) {
+	t.field(field).Spatial = indexing()
+}
+
+// StoreAllFields selects if we're storing all fields or not
+func (t *JavaScriptIndexCreationTask) StoreAllFields(storage FieldStorage) {
+	t.field(IndexingFieldAllFields).Storage = storage
+}
+
+// Store registers field to be stored
+func (t *JavaScriptIndexCreationTask) Store(field string, storage FieldStorage) {
+	t.field(field).Storage = storage
+}
+
+// Analyze registers field to be analyzed
+func (t *JavaScriptIndexCreationTask) Analyze(field string, analyzer string) {
+	t.field(field).Analyzer = analyzer
+}
+
+// TermVector registers field to have term vectors
+func (t *JavaScriptIndexCreationTask) TermVector(field string, termVector FieldTermVector) {
+	t.field(field).TermVector = termVector
+}
+
+// Suggestion registers field to be indexed as suggestions
+func (t *JavaScriptIndexCreationTask) Suggestion(field string) {
+	t.field(field).Suggestions = true
+}
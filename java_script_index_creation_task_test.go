@@ -0,0 +1,76 @@
+package ravendb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJavaScriptIndexCreationTask(t *testing.T) {
+	task := NewJavaScriptIndexCreationTask("Users/ByName")
+	task.Maps = []string{"map('Users', u => ({ Name: u.Name, Count: 1 }))"}
+	task.AdditionalSources["utils"] = "function upper(s) { return s.toUpperCase(); }"
+	task.Index("Name", FieldIndexingSearch)
+	task.Store("Name", FieldStorageYes)
+	task.Priority = IndexPriorityHigh
+
+	def := task.CreateIndexDefinition()
+	assert.Equal(t, "Users/ByName", def.Name)
+	assert.Equal(t, IndexTypeJavaScriptMap, def.GetType())
+	assert.True(t, def.IsJavaScript())
+	assert.Nil(t, def.Reduce)
+	assert.Equal(t, task.Maps, def.Maps)
+	assert.Equal(t, IndexPriorityHigh, def.Priority)
+	assert.Equal(t, task.AdditionalSources, def.AdditionalSources)
+	require.NotNil(t, def.Fields["Name"])
+	assert.Equal(t, FieldIndexing(FieldIndexingSearch), def.Fields["Name"].Indexing)
+	assert.Equal(t, FieldStorage(FieldStorageYes), def.Fields["Name"].Storage)
+
+	task.Reduce = "groupBy(x => x.Name).aggregate(g => ({ Name: g.key, Count: g.values.reduce((n, v) => n + v.Count, 0) }))"
+	def = task.CreateIndexDefinition()
+	assert.Equal(t, IndexTypeJavaScriptMapReduce, def.GetType())
+	require.NotNil(t, def.Reduce)
+	assert.Equal(t, task.Reduce, *def.Reduce)
+}
+
+func TestIndexDefinitionDetectsJavaScriptIndexType(t *testing.T) {
+	tests := []struct {
+		maps     []string
+		reduce   string
+		expected IndexType
+	}{
+		{nil, "", IndexTypeMap},
+		{[]string{"from u in docs.Users select new { u.Name }"}, "", IndexTypeMap},
+		{[]string{"\n// users\ndocs.Users.Select(u => new { u.Name })"}, "", IndexTypeMap},
+		{[]string{"from u in docs.Users select new { u.Name, Count = 1 }"}, "from r in results group r by r.Name", IndexTypeMapReduce},
+		{[]string{"/* users */ map('Users', u => ({ Name: u.Name }))"}, "", IndexTypeJavaScriptMap},
+		{[]string{"map('Users', u => ({ Name: u.Name, Count: 1 }))"}, "groupBy(x => x.Name)", IndexTypeJavaScriptMapReduce},
+	}
+	for _, test := range tests {
+		def := NewIndexDefinition()
+		def.Maps = test.maps
+		if test.reduce != "" {
+			reduce := test.reduce
+			def.Reduce = &reduce
+		}
+		assert.Equal(t, test.expected, def.GetType(), "maps: %v", test.maps)
+	}
+}
+
+func TestIndexCreationCreateIndexesToAdd(t *testing.T) {
+	task := NewIndexCreationTask("Users/ByName")
+	task.Map = "from u in docs.Users select new { u.Name }"
+	jsTask := NewJavaScriptIndexCreationTask("Users/ByAge")
+	jsTask.Maps = []string{"map('Users', u => ({ Age: u.Age }))"}
+	jsTask.Priority = IndexPriorityLow
+
+	defs := indexCreationCreateIndexesToAdd([]IAbstractIndexCreationTask{task, jsTask}, NewDocumentConventions())
+	require.Len(t, defs, 2)
+	assert.Equal(t, "Users/ByName", defs[0].Name)
+	assert.Equal(t, IndexTypeMap, defs[0].GetType())
+	assert.Equal(t, IndexPriorityNormal, defs[0].Priority)
+	assert.Equal(t, "Users/ByAge", defs[1].Name)
+	assert.Equal(t, IndexTypeJavaScriptMap, defs[1].GetType())
+	assert.Equal(t, IndexPriorityLow, defs[1].Priority)
+}
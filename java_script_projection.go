@@ -0,0 +1,165 @@
+package ravendb
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var javaScriptIdentifierRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// JavaScriptProjection builds a projection computed with JavaScript on the
+// server, for use with DocumentQuery.SelectJavaScript. For example:
+//
+//	NewJavaScriptProjection("u").
+//		Load("u.Company", "c").
+//		Field("FullName", "u.FirstName + ' ' + u.LastName").
+//		Field("CompanyName", "c.Name")
+//
+// results in:
+//
+//	from Users as u load u.Company as c select { FullName: u.FirstName + ' ' + u.LastName, CompanyName: c.Name }
+type JavaScriptProjection struct {
+	alias        string
+	declareToken *declareToken
+	loadTokens   []*loadToken
+	fields       []string
+	expression   string
+	err          error
+}
+
+// NewJavaScriptProjection returns a projection in which the queried
+// document is available as alias
+func NewJavaScriptProjection(alias string) *JavaScriptProjection {
+	p := &JavaScriptProjection{
+		alias: alias,
+	}
+	p.err = checkJavaScriptProjectionAlias(alias)
+	return p
+}
+
+func checkJavaScriptProjectionAlias(alias string) error {
+	if !javaScriptIdentifierRegex.MatchString(alias) {
+		return newIllegalArgumentError("alias '%s' is not a valid identifier", alias)
+	}
+	if isRqlTokenKeyword(strings.ToLower(alias)) {
+		return newIllegalArgumentError("alias '%s' is a reserved keyword", alias)
+	}
+	return nil
+}
+
+// Load loads a related document whose id is at path (e.g. "u.Company")
+// and makes it available as alias
+func (p *JavaScriptProjection) Load(path string, alias string) *JavaScriptProjection {
+	if p.err != nil {
+		return p
+	}
+	if path == "" {
+		p.err = newIllegalArgumentError("path cannot be empty")
+		return p
+	}
+	if p.err = checkJavaScriptProjectionAlias(alias); p.err != nil {
+		return p
+	}
+	if alias == p.alias {
+		p.err = newIllegalArgumentError("alias '%s' is already used for the queried document", alias)
+		return p
+	}
+	for _, t := range p.loadTokens {
+		if t.alias == alias {
+			p.err = newIllegalArgumentError("alias '%s' is already used by another load", alias)
+			return p
+		}
+	}
+	p.loadTokens = append(p.loadTokens, &loadToken{
+		argument: path,
+		alias:    alias,
+	})
+	return p
+}
+
+// Declare declares a JavaScript function that can be called from
+// expressions of the projection. Only one function can be declared
+func (p *JavaScriptProjection) Declare(name string, parameters string, body string) *JavaScriptProjection {
+	if p.err != nil {
+		return p
+	}
+	if !javaScriptIdentifierRegex.MatchString(name) {
+		p.err = newIllegalArgumentError("function name '%s' is not a valid identifier", name)
+		return p
+	}
+	if p.declareToken != nil {
+		p.err = newIllegalStateError("function '%s' is already declared, only one function can be declared", p.declareToken.name)
+		return p
+	}
+	p.declareToken = &declareToken{
+		name:       name,
+		parameters: parameters,
+		body:       body,
+	}
+	return p
+}
+
+// Field adds a field to the projected object, computed with a JavaScript
+// expression. The name should match a field in the projection struct
+func (p *JavaScriptProjection) Field(name string, expression string) *JavaScriptProjection {
+	if p.err != nil {
+		return p
+	}
+	if name == "" {
+		p.err = newIllegalArgumentError("name cannot be empty")
+		return p
+	}
+	if expression == "" {
+		p.err = newIllegalArgumentError("expression for field '%s' cannot be empty", name)
+		return p
+	}
+	if p.expression != "" {
+		p.err = newIllegalStateError("cannot use Field() together with Select()")
+		return p
+	}
+	if !javaScriptIdentifierRegex.MatchString(name) {
+		name = strconv.Quote(name)
+	}
+	p.fields = append(p.fields, name+": "+expression)
+	return p
+}
+
+// Select sets a JavaScript expression that returns the whole projected
+// object, e.g. a call to a function added with Declare
+func (p *JavaScriptProjection) Select(expression string) *JavaScriptProjection {
+	if p.err != nil {
+		return p
+	}
+	if expression == "" {
+		p.err = newIllegalArgumentError("expression cannot be empty")
+		return p
+	}
+	if len(p.fields) > 0 {
+		p.err = newIllegalStateError("cannot use Select() together with Field()")
+		return p
+	}
+	p.expression = expression
+	return p
+}
+
+func (p *JavaScriptProjection) toQueryData() (*QueryData, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	expression := p.expression
+	if expression == "" {
+		if len(p.fields) == 0 {
+			return nil, newIllegalStateError("projection has no fields")
+		}
+		expression = "{ " + strings.Join(p.fields, ", ") + " }"
+	}
+	res := &QueryData{
+		Fields:           []string{expression},
+		fromAlias:        p.alias,
+		declareToken:     p.declareToken,
+		loadTokens:       p.loadTokens,
+		isCustomFunction: true,
+	}
+	return res, nil
+}
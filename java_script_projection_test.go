@@ -0,0 +1,57 @@
+package ravendb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type javaScriptProjectionResult struct {
+	FullName    string
+	CompanyName string `json:"Company Name"`
+}
+
+func TestJavaScriptProjection(t *testing.T) {
+	// queries are only built, the server is never contacted
+	store := NewDocumentStore([]string{"http://127.0.0.1:1"}, "db")
+	require.NoError(t, store.Initialize())
+	defer store.Close()
+	session, err := store.OpenSession("")
+	require.NoError(t, err)
+	defer session.Close()
+	resultType := reflect.TypeOf(&javaScriptProjectionResult{})
+
+	projection := NewJavaScriptProjection("u").
+		Load("u.Company", "c").
+		Field("FullName", "u.FirstName + ' ' + u.LastName").
+		Field("Company Name", "c.Name")
+	q := session.QueryCollection("Users").WhereEquals("u.FirstName", "John").SelectJavaScript(resultType, projection)
+	iq, err := q.GetIndexQuery()
+	require.NoError(t, err)
+	assert.Equal(t, `from Users as u where u.FirstName = $p0 load u.Company as c select { FullName: u.FirstName + ' ' + u.LastName, "Company Name": c.Name }`, iq.GetQuery())
+
+	projection = NewJavaScriptProjection("u").
+		Declare("output", "u", "return { FullName: u.FirstName + ' ' + u.LastName };").
+		Select("output(u)")
+	q = session.QueryCollection("Users").SelectJavaScript(resultType, projection)
+	iq, err = q.GetIndexQuery()
+	require.NoError(t, err)
+	assert.Equal(t, "declare function output(u) {\nreturn { FullName: u.FirstName + ' ' + u.LastName };\n}\nfrom Users as u select output(u)", iq.GetQuery())
+
+	invalid := []*JavaScriptProjection{
+		NewJavaScriptProjection("u"),
+		NewJavaScriptProjection("").Field("Name", "u.Name"),
+		NewJavaScriptProjection("load").Field("Name", "load.Name"),
+		NewJavaScriptProjection("u").Load("u.Company", "u").Field("Name", "u.Name"),
+		NewJavaScriptProjection("u").Load("u.Company", "c").Load("u.Manager", "c").Field("Name", "u.Name"),
+		NewJavaScriptProjection("u").Field("Name", ""),
+		NewJavaScriptProjection("u").Field("Name", "u.Name").Select("u"),
+		NewJavaScriptProjection("u").Declare("f", "u", "return u;").Declare("g", "u", "return u;").Select("f(u)"),
+	}
+	for i, projection := range invalid {
+		q = session.QueryCollection("Users").SelectJavaScript(resultType, projection)
+		assert.Error(t, q.Err(), "projection %d", i)
+	}
+}
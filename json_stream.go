@@ -0,0 +1,129 @@
+package ravendb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// ravenCommandStreamingResponse is implemented by commands that can decode
+// their response directly from http response body, without first reading
+// the whole body into memory
+type ravenCommandStreamingResponse interface {
+	setResponseStream(r io.Reader, fromCache bool) error
+}
+
+// jsonDecodeStream decodes JSON object read from r into v, which must be a
+// pointer to a pointer to struct. Like json.Unmarshal, it allocates the struct
+// and sets v to nil if JSON is null.
+// Unlike json.Unmarshal, it doesn't need the whole JSON in memory: elements
+// of a top-level array arrayField (like Results of a query) are decoded one
+// by one by decodeElement, which must consume exactly one value from dec.
+// Other fields are decoded into the struct by encoding/json.
+// Empty input is not an error and leaves v unchanged.
+func jsonDecodeStream(r io.Reader, v interface{}, arrayField string, decodeElement func(dec *json.Decoder) error) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Ptr || rv.Elem().Type().Elem().Kind() != reflect.Struct {
+		return newIllegalArgumentError("v must be a pointer to a pointer to struct, is %T", v)
+	}
+	ptr := rv.Elem()
+
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		ptr.Set(reflect.Zero(ptr.Type()))
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("expected JSON object, got %v", tok)
+	}
+
+	res := reflect.New(ptr.Type().Elem())
+	for dec.More() {
+		tok, err = dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := tok.(string)
+		if key == arrayField {
+			err = jsonDecodeArrayStream(dec, decodeElement)
+		} else {
+			err = jsonDecodeField(dec, key, res.Interface())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	// consume closing '}'
+	if _, err = dec.Token(); err != nil {
+		return err
+	}
+	ptr.Set(res)
+	return nil
+}
+
+// jsonDecodeField decodes a value of a field key from dec into struct v.
+// We let encoding/json decode it as {"<key>": <value>} so that it finds
+// the field exactly like json.Unmarshal does
+func jsonDecodeField(dec *json.Decoder, key string, v interface{}) error {
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+	name, err := jsonMarshal(key)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	buf.Grow(len(name) + len(raw) + 3)
+	buf.WriteByte('{')
+	buf.Write(name)
+	buf.WriteByte(':')
+	buf.Write(raw)
+	buf.WriteByte('}')
+	return jsonUnmarshal(buf.Bytes(), v)
+}
+
+// jsonDecodeArrayStream decodes JSON array from dec, calling fn for each element.
+// fn must consume exactly one value from dec. JSON null is treated as an empty array
+func jsonDecodeArrayStream(dec *json.Decoder, fn func(dec *json.Decoder) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected JSON array, got %v", tok)
+	}
+	for dec.More() {
+		if err = fn(dec); err != nil {
+			return err
+		}
+	}
+	// consume closing ']'
+	_, err = dec.Token()
+	return err
+}
+
+// jsonDecodeMapsStream returns a function for jsonDecodeStream that decodes
+// array elements as map[string]interface{} and appends them to *results
+func jsonDecodeMapsStream(results *[]map[string]interface{}) func(dec *json.Decoder) error {
+	return func(dec *json.Decoder) error {
+		var result map[string]interface{}
+		if err := dec.Decode(&result); err != nil {
+			return err
+		}
+		*results = append(*results, result)
+		return nil
+	}
+}
@@ -0,0 +1,235 @@
+package ravendb
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testQueryResultJSON = `{
+	"TotalResults": 2,
+	"SkippedResults": 0,
+	"DurationInMs": 3,
+	"IndexName": "Auto/Users/Byname",
+	"IsStale": false,
+	"ResultEtag": -123,
+	"IncludedPaths": ["addressId"],
+	"Unknown": {"nested": [1, 2, {"x": null}]},
+	"Results": [
+		{"name": "John", "age": 3, "@metadata": {"@id": "users/1", "@collection": "Users"}},
+		{"name": "Jane", "tags": ["a", "b"], "@metadata": {"@id": "users/2", "@collection": "Users"}}
+	],
+	"Includes": {"addresses/1": {"city": "NY"}},
+	"timingsInMs": {"Query": 1.5}
+}`
+
+func TestJSONDecodeStreamMatchesUnmarshal(t *testing.T) {
+	var exp *QueryResult
+	err := jsonUnmarshal([]byte(testQueryResultJSON), &exp)
+	require.NoError(t, err)
+
+	cmd := &QueryCommand{}
+	err = cmd.SetResponse([]byte(testQueryResultJSON), false)
+	require.NoError(t, err)
+	got := cmd.Result
+	assert.Equal(t, exp, got)
+	assert.Equal(t, 2, len(got.Results))
+	assert.Equal(t, "Auto/Users/Byname", got.IndexName)
+	assert.Equal(t, 1.5, got.TimingsInMs["Query"])
+
+	docsCmd := &GetDocumentsCommand{}
+	err = docsCmd.SetResponse([]byte(`{"Results":[{"@metadata":{"@id":"users/1"}},null],"Includes":{}}`), false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(docsCmd.Result.Results))
+	assert.Nil(t, docsCmd.Result.Results[1])
+
+	docsCmd = &GetDocumentsCommand{}
+	err = docsCmd.SetResponse(nil, false)
+	assert.NoError(t, err)
+	assert.Nil(t, docsCmd.Result)
+
+	docsCmd.Result = &GetDocumentsResult{}
+	err = docsCmd.SetResponse([]byte("null"), false)
+	assert.NoError(t, err)
+	assert.Nil(t, docsCmd.Result)
+
+	err = docsCmd.SetResponse([]byte(`{"Results": [{"a": 1}`), false)
+	assert.Error(t, err)
+	err = docsCmd.SetResponse([]byte(`[1]`), false)
+	assert.Error(t, err)
+	err = jsonDecodeStream(strings.NewReader(`{}`), docsCmd.Result, "Results", nil)
+	assert.Error(t, err)
+}
+
+type JSONStreamInner struct {
+	Inner string
+}
+
+type jsonStreamNamed struct {
+	Named string
+}
+
+type jsonStreamTest struct {
+	*JSONStreamInner
+	jsonStreamNamed `json:"named"`
+	Name            string
+	NAME            string `json:"-"`
+	Items           []int
+}
+
+func TestJSONDecodeStreamResolvesFieldsLikeUnmarshal(t *testing.T) {
+	js := `{"Inner": "inner", "named": {"Named": "x"}, "name": "n", "NAME": "N", "Items": [1, 2, 3]}`
+	var exp *jsonStreamTest
+	require.NoError(t, jsonUnmarshal([]byte(js), &exp))
+
+	var got *jsonStreamTest
+	var items []int
+	err := jsonDecodeStream(strings.NewReader(js), &got, "Items", func(dec *json.Decoder) error {
+		var item int
+		err := dec.Decode(&item)
+		items = append(items, item)
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+	got.Items = items
+	assert.Equal(t, exp, got)
+	assert.Equal(t, "inner", got.Inner)
+	assert.Equal(t, "x", got.Named)
+}
+
+func TestQueryCommandDecodesAndCachesStreamedResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"A:1"`)
+		_, _ = w.Write([]byte(testQueryResultJSON))
+	}))
+	defer srv.Close()
+
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(srv.URL, "db", nil, nil, NewDocumentConventions())
+	defer re.Close()
+
+	cmd, err := NewQueryCommand(re.GetConventions(), NewIndexQuery("from Users"), false, false)
+	require.NoError(t, err)
+	err = re.ExecuteCommand(cmd, nil)
+	require.NoError(t, err)
+	require.NotNil(t, cmd.Result)
+	assert.Equal(t, 2, len(cmd.Result.Results))
+	assert.Equal(t, 2, cmd.Result.TotalResults)
+	assert.Equal(t, 1, re.Cache.GetNumberOfItems())
+
+	url := srv.URL + "/databases/db/queries?queryHash=" + NewIndexQuery("from Users").GetQueryHash()
+	item, cv, cached := re.Cache.get(url)
+	item.close()
+	require.NotNil(t, cv)
+	assert.Equal(t, "A:1", *cv)
+	assert.True(t, bytes.Contains(cached, []byte(`"Jane"`)))
+}
+
+func TestMultiGetCommandDecodesStreamedResponse(t *testing.T) {
+	cache := newHttpCache(1024 * 1024)
+	commands := []*getRequest{
+		{url: "/docs", query: "?id=users/1"},
+		{url: "/docs", query: "?id=users/2"},
+	}
+	cmd := newMultiGetCommand(cache, commands)
+	cmd.baseURL = "http://localhost/databases/db"
+	body := `{"Results": [
+		{"Result": {"Results": [{"name": "John"}]}, "StatusCode": 200, "Headers": {"ETag": "\"A:1\""}},
+		{"Result": null, "StatusCode": 404, "Headers": {}}
+	]}`
+	err := cmd.SetResponseRaw(nil, strings.NewReader(body))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(cmd.Result))
+	assert.Equal(t, `{"Results": [{"name": "John"}]}`, string(cmd.Result[0].Result))
+	assert.Equal(t, http.StatusNotFound, cmd.Result[1].StatusCode)
+	assert.Equal(t, 1, cache.GetNumberOfItems())
+
+	cmd = newMultiGetCommand(cache, commands[:1])
+	err = cmd.SetResponseRaw(nil, strings.NewReader(body))
+	assert.Error(t, err)
+}
+
+type jsonStreamUser struct {
+	ID   string
+	Name string
+	Age  int
+}
+
+func TestQueryWithoutTrackingDecodesTypedResults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"TotalResults": 2, "Results": [
+			{"Name": "John", "Age": 3, "@metadata": {"@id": "users/1", "@change-vector": "A:1"}},
+			{"Name": "Jane", "@metadata": {"@id": "users/2", "@change-vector": "A:2"}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	store := NewDocumentStore([]string{srv.URL}, "db")
+	store.GetConventions().SetDisableTopologyUpdates(true)
+	require.NoError(t, store.Initialize())
+	defer store.Close()
+	session, err := store.OpenSession("")
+	require.NoError(t, err)
+	defer session.Close()
+
+	q := session.QueryCollection("users").NoTracking()
+	var users []*jsonStreamUser
+	require.NoError(t, q.GetResults(&users))
+	assert.Equal(t, []*jsonStreamUser{{ID: "users/1", Name: "John", Age: 3}, {ID: "users/2", Name: "Jane"}}, users)
+	// results were decoded without going through maps
+	queryResult := q.queryOperation.currentQueryResults
+	assert.Equal(t, 0, len(queryResult.Results))
+	assert.Equal(t, 2, len(queryResult.typedResults))
+
+	// results of an executed query can't be returned as a different type
+	var names []*JSONStreamInner
+	assert.Error(t, q.GetResults(&names))
+
+	// tracked entities need documents so they're decoded as maps
+	var tracked []*jsonStreamUser
+	err = session.QueryCollection("users").GetResults(&tracked)
+	require.NoError(t, err)
+	assert.Equal(t, users, tracked)
+	assert.True(t, session.IsLoaded("users/1"))
+}
+
+func TestStreamedResponseIsCachedUpToMaxHttpCacheSize(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"A:1"`)
+		_, _ = w.Write([]byte(testQueryResultJSON))
+	}))
+	defer srv.Close()
+
+	query := func(maxCacheSize int) *RequestExecutor {
+		conventions := NewDocumentConventions()
+		require.NoError(t, conventions.SetMaxHttpCacheSize(maxCacheSize))
+		re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(srv.URL, "db", nil, nil, conventions)
+		t.Cleanup(re.Close)
+
+		cmd, err := NewQueryCommand(re.GetConventions(), NewIndexQuery("from Users"), false, false)
+		require.NoError(t, err)
+		require.NoError(t, re.ExecuteCommand(cmd, nil))
+		assert.Equal(t, 2, len(cmd.Result.Results))
+		return re
+	}
+
+	// big responses are cached as long as they fit in the cache
+	re := query(len(testQueryResultJSON) + 64)
+	assert.Equal(t, 1, re.Cache.GetNumberOfItems())
+
+	re = query(len(testQueryResultJSON) / 2)
+	assert.Equal(t, 0, re.Cache.GetNumberOfItems())
+
+	buf := &limitedBuffer{limit: 4}
+	_, _ = buf.Write([]byte("abc"))
+	assert.Equal(t, "abc", buf.String())
+	_, _ = buf.Write([]byte("de"))
+	assert.True(t, buf.exceeded)
+	assert.Equal(t, 0, buf.Len())
+}
@@ -33,3 +33,33 @@ func (c *KillOperationCommand) CreateRequest(node *ServerNode) (*http.Request, e
 
 	return NewHttpPost(url, nil)
 }
+
+var (
+	_ RavenCommand = &KillServerOperationCommand{}
+)
+
+// KillServerOperationCommand represents "kill operation" command for
+// server-wide operations
+type KillServerOperationCommand struct {
+	RavenCommandBase
+
+	id int64
+}
+
+// NewKillServerOperationCommand returns new KillServerOperationCommand
+func NewKillServerOperationCommand(id int64) *KillServerOperationCommand {
+	cmd := &KillServerOperationCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		id: id,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+
+	return cmd
+}
+
+func (c *KillServerOperationCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/admin/operations/kill?id=" + i64toa(c.id)
+
+	return NewHttpPost(url, nil)
+}
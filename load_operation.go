@@ -13,6 +13,11 @@ type LoadOperation struct {
 	ids                []string
 	includes           []string
 	idsToCheckOnServer []string
+
+	countersToInclude  []string
+	includeAllCounters bool
+
+	timeSeriesToInclude []*TimeSeriesRange
 }
 
 func NewLoadOperation(session *InMemoryDocumentSessionOperations) *LoadOperation {
@@ -34,7 +39,12 @@ func (o *LoadOperation) createRequest() (*GetDocumentsCommand, error) {
 		return nil, err
 	}
 
-	return NewGetDocumentsCommand(o.idsToCheckOnServer, o.includes, false)
+	cmd, err := NewGetDocumentsCommandWithCounters(o.idsToCheckOnServer, o.includes, o.countersToInclude, o.includeAllCounters, false)
+	if err != nil {
+		return nil, err
+	}
+	cmd._timeSeriesIncludes = o.timeSeriesToInclude
+	return cmd, nil
 }
 
 func (o *LoadOperation) byID(id string) *LoadOperation {
@@ -59,6 +69,17 @@ func (o *LoadOperation) withIncludes(includes []string) *LoadOperation {
 	return o
 }
 
+func (o *LoadOperation) withCounters(counters []string, includeAll bool) *LoadOperation {
+	o.countersToInclude = counters
+	o.includeAllCounters = includeAll
+	return o
+}
+
+func (o *LoadOperation) withTimeSeries(timeSeries []*TimeSeriesRange) *LoadOperation {
+	o.timeSeriesToInclude = timeSeries
+	return o
+}
+
 func (o *LoadOperation) byIds(ids []string) *LoadOperation {
 	o.ids = stringArrayCopy(ids)
 
@@ -153,6 +174,11 @@ func (o *LoadOperation) setResult(result *GetDocumentsResult) {
 
 	o.session.registerIncludes(result.Includes)
 
+	if o.includeAllCounters || len(o.countersToInclude) > 0 {
+		o.session.registerCounters(result.CounterIncludes, o.idsToCheckOnServer, o.countersToInclude, o.includeAllCounters)
+	}
+	o.session.registerTimeSeries(result.TimeSeriesIncludes)
+
 	results := result.Results
 	for _, document := range results {
 		// TODO: Java also does document.isNull()
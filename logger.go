@@ -0,0 +1,114 @@
+package ravendb
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Logger receives leveled, structured events from the client, like
+// failovers, topology updates or reconnects of changes and subscriptions.
+// args are alternating keys and values, the same as in log/slog, which
+// means *slog.Logger can be used as Logger directly
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+var (
+	_ Logger = nopLogger{}
+	_ Logger = &logLogger{}
+)
+
+// nopLogger discards all events
+type nopLogger struct{}
+
+func (nopLogger) Debug(msg string, args ...interface{}) {}
+func (nopLogger) Info(msg string, args ...interface{})  {}
+func (nopLogger) Warn(msg string, args ...interface{})  {}
+func (nopLogger) Error(msg string, args ...interface{}) {}
+
+// isLoggerEnabled returns false if events sent to l are discarded, so that
+// hot paths can skip preparing them
+func isLoggerEnabled(l Logger) bool {
+	_, isNop := l.(nopLogger)
+	return !isNop
+}
+
+// logCommand is a value of a log event that formats as type of the command.
+// The type name is only formatted when the event is written
+type logCommand struct {
+	command RavenCommand
+}
+
+func (c logCommand) String() string {
+	return fmt.Sprintf("%T", c.command)
+}
+
+type logLogger struct {
+	logger *log.Logger
+}
+
+// NewLogLogger returns Logger which writes events of all levels to a given
+// *log.Logger, formatted as: level=INFO msg="..." key=value
+func NewLogLogger(logger *log.Logger) Logger {
+	return &logLogger{
+		logger: logger,
+	}
+}
+
+func (l *logLogger) Debug(msg string, args ...interface{}) {
+	l.logger.Print(formatLogEvent("DEBUG", msg, args))
+}
+
+func (l *logLogger) Info(msg string, args ...interface{}) {
+	l.logger.Print(formatLogEvent("INFO", msg, args))
+}
+
+func (l *logLogger) Warn(msg string, args ...interface{}) {
+	l.logger.Print(formatLogEvent("WARN", msg, args))
+}
+
+func (l *logLogger) Error(msg string, args ...interface{}) {
+	l.logger.Print(formatLogEvent("ERROR", msg, args))
+}
+
+func formatLogValue(v interface{}) string {
+	s := fmt.Sprint(v)
+	if s == "" || strings.ContainsAny(s, " =\"\t\n") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+func formatLogEvent(level string, msg string, args []interface{}) string {
+	var sb strings.Builder
+	sb.WriteString("level=" + level + " msg=" + formatLogValue(msg))
+	for i := 0; i < len(args); i += 2 {
+		key := fmt.Sprint(args[i])
+		if i+1 == len(args) {
+			// same as slog, a key without a value
+			sb.WriteString(" !BADKEY=" + formatLogValue(key))
+			break
+		}
+		sb.WriteString(" " + key + "=" + formatLogValue(args[i+1]))
+	}
+	return sb.String()
+}
+
+// getLogger returns Logger to use. If Logger is not set, events are
+// discarded unless one of the deprecated debug flags is set, in which
+// case they are printed to stdout
+func (c *DocumentConventions) getLogger() Logger {
+	if c.Logger != nil {
+		return c.Logger
+	}
+	if DebugLogRequestExecutor || DebugTopology {
+		return NewLogLogger(log.New(os.Stdout, "", 0))
+	}
+	return nopLogger{}
+}
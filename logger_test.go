@@ -0,0 +1,41 @@
+package ravendb
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatLogEvent(t *testing.T) {
+	s := formatLogEvent("INFO", "updated topology", []interface{}{"nodes", 3, "url", "http://a b", "error", errors.New("failed")})
+	assert.Equal(t, `level=INFO msg="updated topology" nodes=3 url="http://a b" error=failed`, s)
+
+	s = formatLogEvent("DEBUG", "closing", []interface{}{"database", ""})
+	assert.Equal(t, `level=DEBUG msg=closing database=""`, s)
+
+	s = formatLogEvent("WARN", "odd", []interface{}{"key"})
+	assert.Equal(t, `level=WARN msg=odd !BADKEY=key`, s)
+}
+
+func TestConventionsLogger(t *testing.T) {
+	conventions := NewDocumentConventions()
+	_, ok := conventions.getLogger().(nopLogger)
+	assert.True(t, ok)
+
+	var buf bytes.Buffer
+	conventions.Logger = NewLogLogger(log.New(&buf, "", 0))
+	// the logger is shared with request executors which use a copy of conventions
+	conventions.Clone().getLogger().Warn("request failed", "node", "A")
+	assert.Equal(t, "level=WARN msg=\"request failed\" node=A\n", buf.String())
+}
+
+func TestLogCommand(t *testing.T) {
+	assert.False(t, isLoggerEnabled(nopLogger{}))
+	assert.True(t, isLoggerEnabled(NewLogLogger(log.New(&bytes.Buffer{}, "", 0))))
+
+	s := formatLogEvent("DEBUG", "executing command", []interface{}{"command", logCommand{NewGetStatisticsCommand("")}})
+	assert.Equal(t, `level=DEBUG msg="executing command" command=*ravendb.GetStatisticsCommand`, s)
+}
@@ -1,6 +1,10 @@
 package ravendb
 
-import "strings"
+import (
+	"context"
+	"strings"
+	"time"
+)
 
 type MaintenanceOperationExecutor struct {
 	store                   *DocumentStore
@@ -46,6 +50,12 @@ func (e *MaintenanceOperationExecutor) ForDatabase(databaseName string) *Mainten
 }
 
 func (e *MaintenanceOperationExecutor) Send(operation IMaintenanceOperation) error {
+	return e.SendCtx(context.Background(), operation)
+}
+
+// SendCtx is like Send but the request to the server is cancelled
+// when ctx is done
+func (e *MaintenanceOperationExecutor) SendCtx(ctx context.Context, operation IMaintenanceOperation) error {
 	if err := e.assertDatabaseNameSet(); err != nil {
 		return err
 	}
@@ -53,7 +63,7 @@ func (e *MaintenanceOperationExecutor) Send(operation IMaintenanceOperation) err
 	if err != nil {
 		return err
 	}
-	return e.GetRequestExecutor().ExecuteCommand(command, nil)
+	return e.GetRequestExecutor().ExecuteCommandCtx(ctx, command, nil)
 }
 
 func (e *MaintenanceOperationExecutor) SendAsync(operation IMaintenanceOperation) (*Operation, error) {
@@ -68,11 +78,37 @@ func (e *MaintenanceOperationExecutor) SendAsync(operation IMaintenanceOperation
 		return nil, err
 	}
 	fn := func() *DatabaseChanges {
-		return e.store.Changes("")
+		return e.store.Changes(e.databaseName)
 	}
 	re := e.GetRequestExecutor()
 	id := getCommandOperationIDResult(command)
-	return NewOperation(re, fn, re.GetConventions(), id.OperationID), nil
+	op := NewOperation(re, fn, re.GetConventions(), id.OperationID)
+	op.NodeTag = id.OperationNodeTag
+	return op, nil
+}
+
+// WaitForIndexing waits until indexes with given names (all indexes if
+// none are given) in a database are non-stale. database is optional.
+// Returns IndexFailedError if an index is faulty or in error state and
+// TimeoutError if indexes are still stale after timeout (default 1 minute)
+func (e *MaintenanceOperationExecutor) WaitForIndexing(database string, timeout time.Duration, indexNames ...string) error {
+	options := &WaitForIndexingOptions{
+		Timeout:    timeout,
+		IndexNames: indexNames,
+	}
+	return e.WaitForIndexingWithOptions(database, options)
+}
+
+// WaitForIndexingWithOptions is like WaitForIndexing but with options
+func (e *MaintenanceOperationExecutor) WaitForIndexingWithOptions(database string, options *WaitForIndexingOptions) error {
+	if options == nil {
+		options = &WaitForIndexingOptions{}
+	}
+	executor := e
+	if database != "" {
+		executor = e.ForDatabase(database)
+	}
+	return waitForIndexing(executor, options)
 }
 
 func (e *MaintenanceOperationExecutor) assertDatabaseNameSet() error {
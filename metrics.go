@@ -0,0 +1,209 @@
+package ravendb
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// upper bounds of buckets of subscription batch size histogram
+var metricsSubscriptionBatchSizeBuckets = []int{1, 10, 100, 1000, 10000}
+
+// Metrics collects statistics about requests sent by the client, failovers,
+// http cache, changes and subscriptions of a DocumentStore.
+// They can be exposed in Prometheus text format with WritePrometheus or by
+// registering Metrics as http.Handler
+type Metrics struct {
+	// command type => number of requests
+	requests sync.Map // string => *int64
+
+	failovers         int64 // atomic
+	serverDown        int64 // atomic
+	changesReconnects int64 // atomic
+
+	mu                      sync.Mutex
+	subscriptionBatches     int64
+	subscriptionBatchItems  int64
+	subscriptionBatchCounts []int64 // per bucket, not cumulative
+
+	// returns combined stats of http caches of request executors
+	cacheStats func() *HttpCacheStats
+}
+
+var _ http.Handler = &Metrics{}
+
+func newMetrics(cacheStats func() *HttpCacheStats) *Metrics {
+	return &Metrics{
+		subscriptionBatchCounts: make([]int64, len(metricsSubscriptionBatchSizeBuckets)),
+		cacheStats:              cacheStats,
+	}
+}
+
+// Note: methods recording events are safe to call on nil *Metrics, which is
+// the case for RequestExecutor not created by DocumentStore
+
+func (m *Metrics) requestSent(commandType string) {
+	if m == nil {
+		return
+	}
+	v, ok := m.requests.Load(commandType)
+	if !ok {
+		v, _ = m.requests.LoadOrStore(commandType, new(int64))
+	}
+	atomic.AddInt64(v.(*int64), 1)
+}
+
+func (m *Metrics) failover() {
+	if m != nil {
+		atomic.AddInt64(&m.failovers, 1)
+	}
+}
+
+func (m *Metrics) serverDownDetected() {
+	if m != nil {
+		atomic.AddInt64(&m.serverDown, 1)
+	}
+}
+
+func (m *Metrics) changesReconnected() {
+	if m != nil {
+		atomic.AddInt64(&m.changesReconnects, 1)
+	}
+}
+
+func (m *Metrics) subscriptionBatchReceived(size int) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.subscriptionBatches++
+	m.subscriptionBatchItems += int64(size)
+	for i, bound := range metricsSubscriptionBatchSizeBuckets {
+		if size <= bound {
+			m.subscriptionBatchCounts[i]++
+			break
+		}
+	}
+}
+
+// GetNumberOfRequests returns number of requests sent to the server for
+// commands of a given type e.g. "GetDocumentsCommand"
+func (m *Metrics) GetNumberOfRequests(commandType string) int64 {
+	v, ok := m.requests.Load(commandType)
+	if !ok {
+		return 0
+	}
+	return atomic.LoadInt64(v.(*int64))
+}
+
+// GetNumberOfFailovers returns number of times a request was re-tried on
+// another node
+func (m *Metrics) GetNumberOfFailovers() int64 {
+	return atomic.LoadInt64(&m.failovers)
+}
+
+// GetNumberOfChangesReconnects returns number of times changes websocket
+// connection was re-established
+func (m *Metrics) GetNumberOfChangesReconnects() int64 {
+	return atomic.LoadInt64(&m.changesReconnects)
+}
+
+type prometheusWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (p *prometheusWriter) printf(format string, args ...interface{}) {
+	if p.err == nil {
+		_, p.err = fmt.Fprintf(p.w, format, args...)
+	}
+}
+
+func (p *prometheusWriter) header(name string, typ string, help string) {
+	p.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+func (p *prometheusWriter) counter(name string, help string, v int64) {
+	p.header(name, "counter", help)
+	p.printf("%s %d\n", name, v)
+}
+
+func (p *prometheusWriter) gauge(name string, help string, v int64) {
+	p.header(name, "gauge", help)
+	p.printf("%s %d\n", name, v)
+}
+
+func escapePrometheusLabelValue(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	return strings.Replace(s, "\n", `\n`, -1)
+}
+
+// WritePrometheus writes metrics in Prometheus text exposition format
+func (m *Metrics) WritePrometheus(w io.Writer) error {
+	p := &prometheusWriter{
+		w: bufio.NewWriter(w),
+	}
+
+	var commandTypes []string
+	m.requests.Range(func(key, value interface{}) bool {
+		commandTypes = append(commandTypes, key.(string))
+		return true
+	})
+	sort.Strings(commandTypes)
+	p.header("ravendb_client_requests_total", "counter", "Number of requests sent to the server, by command type.")
+	for _, commandType := range commandTypes {
+		p.printf("ravendb_client_requests_total{command=\"%s\"} %d\n", escapePrometheusLabelValue(commandType), m.GetNumberOfRequests(commandType))
+	}
+
+	p.counter("ravendb_client_failovers_total", "Number of requests re-tried on another node.", m.GetNumberOfFailovers())
+	p.counter("ravendb_client_server_down_total", "Number of requests that failed because a node was down or not responding.", atomic.LoadInt64(&m.serverDown))
+
+	cacheStats := &HttpCacheStats{}
+	if m.cacheStats != nil {
+		cacheStats = m.cacheStats()
+	}
+	p.counter("ravendb_client_http_cache_hits_total", "Number of lookups of http cache that found a response.", cacheStats.Hits)
+	p.counter("ravendb_client_http_cache_misses_total", "Number of lookups of http cache that didn't find a response.", cacheStats.Misses)
+	p.counter("ravendb_client_http_cache_not_modified_total", "Number of cached responses confirmed by the server as not modified.", cacheStats.NotModified)
+	p.counter("ravendb_client_http_cache_evictions_total", "Number of responses evicted from http cache.", cacheStats.Evictions)
+	p.gauge("ravendb_client_http_cache_items", "Number of responses in http cache.", int64(cacheStats.NumberOfItems))
+	p.gauge("ravendb_client_http_cache_size_bytes", "Size of responses in http cache.", cacheStats.SizeInBytes)
+
+	p.counter("ravendb_client_changes_reconnects_total", "Number of times changes connection was re-established.", m.GetNumberOfChangesReconnects())
+
+	m.mu.Lock()
+	batches := m.subscriptionBatches
+	items := m.subscriptionBatchItems
+	counts := append([]int64{}, m.subscriptionBatchCounts...)
+	m.mu.Unlock()
+
+	name := "ravendb_client_subscription_batch_size"
+	p.header(name, "histogram", "Number of documents in batches received by subscription workers.")
+	var cumulative int64
+	for i, bound := range metricsSubscriptionBatchSizeBuckets {
+		cumulative += counts[i]
+		p.printf("%s_bucket{le=\"%s\"} %d\n", name, strconv.Itoa(bound), cumulative)
+	}
+	p.printf("%s_bucket{le=\"+Inf\"} %d\n", name, batches)
+	p.printf("%s_sum %d\n", name, items)
+	p.printf("%s_count %d\n", name, batches)
+
+	if p.err != nil {
+		return p.err
+	}
+	return p.w.Flush()
+}
+
+// ServeHTTP writes metrics in Prometheus text exposition format
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = m.WritePrometheus(w)
+}
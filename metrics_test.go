@@ -0,0 +1,106 @@
+package ravendb
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsWritePrometheus(t *testing.T) {
+	cacheStats := func() *HttpCacheStats {
+		return &HttpCacheStats{
+			Hits:          3,
+			Misses:        2,
+			NotModified:   1,
+			NumberOfItems: 2,
+		}
+	}
+	m := newMetrics(cacheStats)
+	m.requestSent("GetDocumentsCommand")
+	m.requestSent("GetDocumentsCommand")
+	m.requestSent("BatchCommand")
+	m.failover()
+	m.serverDownDetected()
+	m.changesReconnected()
+	m.subscriptionBatchReceived(1)
+	m.subscriptionBatchReceived(50)
+	m.subscriptionBatchReceived(20000)
+
+	assert.Equal(t, int64(2), m.GetNumberOfRequests("GetDocumentsCommand"))
+	assert.Equal(t, int64(0), m.GetNumberOfRequests("QueryCommand"))
+
+	var buf bytes.Buffer
+	err := m.WritePrometheus(&buf)
+	assert.NoError(t, err)
+	s := buf.String()
+	expected := []string{
+		"# TYPE ravendb_client_requests_total counter\n",
+		"ravendb_client_requests_total{command=\"BatchCommand\"} 1\n",
+		"ravendb_client_requests_total{command=\"GetDocumentsCommand\"} 2\n",
+		"ravendb_client_failovers_total 1\n",
+		"ravendb_client_server_down_total 1\n",
+		"ravendb_client_http_cache_hits_total 3\n",
+		"ravendb_client_http_cache_misses_total 2\n",
+		"ravendb_client_http_cache_not_modified_total 1\n",
+		"ravendb_client_http_cache_items 2\n",
+		"ravendb_client_changes_reconnects_total 1\n",
+		"# TYPE ravendb_client_subscription_batch_size histogram\n",
+		"ravendb_client_subscription_batch_size_bucket{le=\"1\"} 1\n",
+		"ravendb_client_subscription_batch_size_bucket{le=\"10\"} 1\n",
+		"ravendb_client_subscription_batch_size_bucket{le=\"100\"} 2\n",
+		"ravendb_client_subscription_batch_size_bucket{le=\"10000\"} 2\n",
+		"ravendb_client_subscription_batch_size_bucket{le=\"+Inf\"} 3\n",
+		"ravendb_client_subscription_batch_size_sum 20051\n",
+		"ravendb_client_subscription_batch_size_count 3\n",
+	}
+	for _, exp := range expected {
+		assert.Contains(t, s, exp)
+	}
+}
+
+func TestMetricsCountRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"CountOfDocuments": 5}`))
+	}))
+	defer srv.Close()
+
+	m := newMetrics(nil)
+	conventions := NewDocumentConventions()
+	conventions.metrics = m
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(srv.URL, "db", nil, nil, conventions)
+	defer re.Close()
+
+	err := re.ExecuteCommand(NewGetStatisticsCommand(""), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), m.GetNumberOfRequests("GetStatisticsCommand"))
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "ravendb_client_requests_total{command=\"GetStatisticsCommand\"} 1\n")
+}
+
+func TestMetricsCacheCountersDontDecreaseWhenExecutorIsClosed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Results":[{"@metadata":{"@id":"users/1"}}],"Includes":{}}`))
+	}))
+	defer srv.Close()
+
+	store := newDocumentStore()
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(srv.URL, "db", nil, nil, store.GetConventions())
+	store.requestsExecutors["db"] = re
+
+	cmd, err := NewGetDocumentsCommand([]string{"users/1"}, nil, false)
+	assert.NoError(t, err)
+	assert.NoError(t, re.ExecuteCommand(cmd, nil))
+	before := store.getHttpCacheStats()
+	assert.Equal(t, int64(1), before.Misses)
+
+	re.Close()
+	after := store.getHttpCacheStats()
+	assert.Equal(t, before.Misses, after.Misses)
+	assert.Equal(t, 0, after.NumberOfItems)
+}
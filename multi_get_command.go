@@ -3,7 +3,6 @@ package ravendb
 import (
 	"encoding/json"
 	"io"
-	"io/ioutil"
 	"net/http"
 )
 
@@ -91,35 +90,55 @@ type getResponseJSON struct {
 	Headers    map[string]string `json:"Headers"`
 }
 
-type resultsJSON struct {
-	Results []*getResponseJSON `json:"Results"`
-}
-
 func (c *MultiGetCommand) SetResponseRaw(response *http.Response, stream io.Reader) error {
-	var results *resultsJSON
-	d, err := ioutil.ReadAll(stream)
+	// results can be big so we decode them one by one instead of reading
+	// the whole response into memory
+	dec := json.NewDecoder(stream)
+	tok, err := dec.Token()
 	if err != nil {
 		return err
 	}
-	err = jsonUnmarshal(d, &results)
-	if err != nil {
-		return err
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return throwInvalidResponse()
 	}
+	for dec.More() {
+		tok, err = dec.Token()
+		if err != nil {
+			return err
+		}
+		if key, _ := tok.(string); key != "Results" {
+			var skip json.RawMessage
+			if err = dec.Decode(&skip); err != nil {
+				return err
+			}
+			continue
+		}
+		err = jsonDecodeArrayStream(dec, func(dec *json.Decoder) error {
+			var rsp getResponseJSON
+			if err := dec.Decode(&rsp); err != nil {
+				return err
+			}
+			i := len(c.Result)
+			if i >= len(c.commands) {
+				return throwInvalidResponse()
+			}
+			command := c.commands[i]
+			var getResponse GetResponse
 
-	for i, rsp := range results.Results {
-		command := c.commands[i]
-		var getResponse GetResponse
-
-		getResponse.StatusCode = rsp.StatusCode
-		getResponse.Headers = rsp.Headers
-		getResponse.Result = rsp.Result
+			getResponse.StatusCode = rsp.StatusCode
+			getResponse.Headers = rsp.Headers
+			getResponse.Result = rsp.Result
 
-		c.maybeSetCache(&getResponse, command)
-		c.maybeReadFromCache(&getResponse, command)
+			c.maybeSetCache(&getResponse, command)
+			c.maybeReadFromCache(&getResponse, command)
 
-		c.Result = append(c.Result, &getResponse)
+			c.Result = append(c.Result, &getResponse)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
 	}
-
 	return nil
 }
 
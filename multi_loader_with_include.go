@@ -2,6 +2,7 @@ package ravendb
 
 import (
 	"reflect"
+	"time"
 )
 
 // ILoaderWithInclude is NewMultiLoaderWithInclude
@@ -9,6 +10,13 @@ import (
 type MultiLoaderWithInclude struct {
 	session  *DocumentSession
 	includes []string
+
+	counters           []string
+	includeAllCounters bool
+
+	timeSeries []*TimeSeriesRange
+
+	err error
 }
 
 func NewMultiLoaderWithInclude(session *DocumentSession) *MultiLoaderWithInclude {
@@ -22,8 +30,46 @@ func (l *MultiLoaderWithInclude) Include(path string) *MultiLoaderWithInclude {
 	return l
 }
 
+// IncludeCounters includes given counters of loaded documents
+func (l *MultiLoaderWithInclude) IncludeCounters(counters ...string) *MultiLoaderWithInclude {
+	if l.includeAllCounters {
+		l.err = newIllegalStateError("IncludeAllCounters() and IncludeCounters() cannot be used together")
+		return l
+	}
+	l.counters = append(l.counters, counters...)
+	return l
+}
+
+// IncludeAllCounters includes all counters of loaded documents
+func (l *MultiLoaderWithInclude) IncludeAllCounters() *MultiLoaderWithInclude {
+	if len(l.counters) > 0 {
+		l.err = newIllegalStateError("IncludeAllCounters() and IncludeCounters() cannot be used together")
+		return l
+	}
+	l.includeAllCounters = true
+	return l
+}
+
+// IncludeTimeSeries includes entries of a given time series between from and to.
+// nil from or to means the range is unbounded
+func (l *MultiLoaderWithInclude) IncludeTimeSeries(name string, from *time.Time, to *time.Time) *MultiLoaderWithInclude {
+	if stringIsBlank(name) {
+		l.err = newIllegalArgumentError("name cannot be empty")
+		return l
+	}
+	l.timeSeries = append(l.timeSeries, &TimeSeriesRange{
+		Name: name,
+		From: from,
+		To:   to,
+	})
+	return l
+}
+
 // results should be map[string]*struct
 func (l *MultiLoaderWithInclude) LoadMulti(results interface{}, ids []string) error {
+	if l.err != nil {
+		return l.err
+	}
 	if len(ids) == 0 {
 		return newIllegalArgumentError("ids cannot be empty array")
 	}
@@ -31,12 +77,15 @@ func (l *MultiLoaderWithInclude) LoadMulti(results interface{}, ids []string) er
 		return err
 	}
 
-	return l.session.loadInternalMulti(results, ids, l.includes)
+	return l.session.loadInternalMultiFull(results, ids, l.includes, l.counters, l.includeAllCounters, l.timeSeries)
 }
 
 // TODO: needs a test
 // TODO: better implementation
 func (l *MultiLoaderWithInclude) Load(result interface{}, id string) error {
+	if l.err != nil {
+		return l.err
+	}
 	if id == "" {
 		return newIllegalArgumentError("id cannot be empty string")
 	}
@@ -52,7 +101,7 @@ func (l *MultiLoaderWithInclude) Load(result interface{}, id string) error {
 	mapType := reflect.MapOf(stringType, rt)
 	m := reflect.MakeMap(mapType)
 	ids := []string{id}
-	err := l.session.loadInternalMulti(m.Interface(), ids, l.includes)
+	err := l.session.loadInternalMultiFull(m.Interface(), ids, l.includes, l.counters, l.includeAllCounters, l.timeSeries)
 	if err != nil {
 		return err
 	}
@@ -61,6 +61,26 @@ func (s *NodeSelector) getPreferredNode() (*CurrentIndexAndNode, error) {
 	return s.unlikelyEveryoneFaultedChoice(state)
 }
 
+func (s *NodeSelector) getRequestedNode(nodeTag string) (*CurrentIndexAndNode, error) {
+	state := s.state
+	stateFailures := state.failures
+	serverNodes := state.nodes
+	n := min(len(serverNodes), len(stateFailures))
+	for i := 0; i < n; i++ {
+		if serverNodes[i].ClusterTag != nodeTag {
+			continue
+		}
+		if stateFailures[i].get() == 0 && serverNodes[i].URL != "" {
+			return NewCurrentIndexAndNode(i, serverNodes[i]), nil
+		}
+		return nil, newRequestedNodeUnavailableError("Requested node %s is currently unavailable, please try again later", nodeTag)
+	}
+	if len(serverNodes) == 0 {
+		return nil, newAllTopologyNodesDownError("There are no nodes in the topology at all")
+	}
+	return nil, newRequestedNodeUnavailableError("Could not find requested node %s", nodeTag)
+}
+
 func (s *NodeSelector) unlikelyEveryoneFaultedChoice(state *NodeSelectorState) (*CurrentIndexAndNode, error) {
 	// if there are all marked as failed, we'll chose the first
 	// one so the user will get an error (or recover :-) );
@@ -0,0 +1,84 @@
+package ravendb
+
+// OngoingTaskType describes a type of an ongoing task
+type OngoingTaskType = string
+
+const (
+	OngoingTaskTypeReplication           = "Replication"
+	OngoingTaskTypeRavenEtl              = "RavenEtl"
+	OngoingTaskTypeSQLEtl                = "SqlEtl"
+	OngoingTaskTypeBackup                = "Backup"
+	OngoingTaskTypeSubscription          = "Subscription"
+	OngoingTaskTypePullReplicationAsHub  = "PullReplicationAsHub"
+	OngoingTaskTypePullReplicationAsSink = "PullReplicationAsSink"
+)
+
+// OngoingTaskState describes if an ongoing task is enabled
+type OngoingTaskState = string
+
+const (
+	OngoingTaskStateEnabled          = "Enabled"
+	OngoingTaskStateDisabled         = "Disabled"
+	OngoingTaskStatePartiallyEnabled = "PartiallyEnabled"
+)
+
+// OngoingTaskConnectionStatus describes the connection of an ongoing task
+// to its destination
+type OngoingTaskConnectionStatus = string
+
+const (
+	OngoingTaskConnectionStatusNone          = "None"
+	OngoingTaskConnectionStatusActive        = "Active"
+	OngoingTaskConnectionStatusNotActive     = "NotActive"
+	OngoingTaskConnectionStatusReconnect     = "Reconnect"
+	OngoingTaskConnectionStatusNotOnThisNode = "NotOnThisNode"
+)
+
+// OngoingTask describes properties common to all ongoing tasks
+type OngoingTask struct {
+	TaskID               int64                       `json:"TaskId"`
+	TaskType             OngoingTaskType             `json:"TaskType"`
+	ResponsibleNode      *NodeID                     `json:"ResponsibleNode"`
+	TaskState            OngoingTaskState            `json:"TaskState"`
+	TaskConnectionStatus OngoingTaskConnectionStatus `json:"TaskConnectionStatus"`
+	TaskName             string                      `json:"TaskName"`
+	Error                string                      `json:"Error"`
+	MentorNode           string                      `json:"MentorNode"`
+}
+
+// OngoingTaskReplication describes an external replication task
+type OngoingTaskReplication struct {
+	OngoingTask
+	DestinationURL        string    `json:"DestinationUrl"`
+	TopologyDiscoveryUrls []string  `json:"TopologyDiscoveryUrls"`
+	DestinationDatabase   string    `json:"DestinationDatabase"`
+	ConnectionStringName  string    `json:"ConnectionStringName"`
+	DelayReplicationFor   *Duration `json:"DelayReplicationFor"`
+}
+
+// OngoingTaskRavenEtlDetails describes a RavenDB ETL task
+type OngoingTaskRavenEtlDetails struct {
+	OngoingTask
+	DestinationURL       string                 `json:"DestinationUrl"`
+	DestinationDatabase  string                 `json:"DestinationDatabase"`
+	ConnectionStringName string                 `json:"ConnectionStringName"`
+	Configuration        *RavenEtlConfiguration `json:"Configuration"`
+}
+
+// OngoingTaskSqlEtlDetails describes an SQL ETL task
+type OngoingTaskSqlEtlDetails struct {
+	OngoingTask
+	Configuration *SqlEtlConfiguration `json:"Configuration"`
+}
+
+// OngoingTaskBackup describes a periodic backup task
+type OngoingTaskBackup struct {
+	OngoingTask
+	BackupType            BackupType       `json:"BackupType"`
+	BackupDestinations    []string         `json:"BackupDestinations"`
+	LastFullBackup        *Time            `json:"LastFullBackup"`
+	LastIncrementalBackup *Time            `json:"LastIncrementalBackup"`
+	RetentionPolicy       *RetentionPolicy `json:"RetentionPolicy"`
+	IsEncrypted           bool             `json:"IsEncrypted"`
+	LastExecutingNodeTag  string           `json:"LastExecutingNodeTag"`
+}
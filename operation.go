@@ -1,36 +1,70 @@
 package ravendb
 
 import (
+	"context"
+	"sync"
 	"time"
 )
 
+const (
+	// status of an operation is polled with backoff between those delays
+	operationPollMinDelay = 100 * time.Millisecond
+	operationPollMaxDelay = time.Second
+	// notifications from changes are the primary source of status so
+	// polling is less frequent when subscribed to them
+	operationPollMaxDelayWithChanges = 5 * time.Second
+)
+
+// OperationProgress describes progress of an operation reported by the server
+type OperationProgress struct {
+	// Processed and Total are set by operations with determinate progress,
+	// like PatchByQueryOperation or DeleteByQueryOperation
+	Processed int64
+	Total     int64
+	// Values is the whole progress payload. Its content depends on the
+	// type of the operation
+	Values map[string]interface{}
+}
+
 // Operation describes async operation being executed on the server
 type Operation struct {
 	requestExecutor *RequestExecutor
-	//TBD private readonly Func<DatabaseChanges> _changes;
-	conventions *DocumentConventions
-	id          int64
+	changes         func() *DatabaseChanges
+	conventions     *DocumentConventions
+	id              int64
 
 	// if true, this represents ServerWideOperation
 	IsServerWide bool
+
+	// NodeTag is a cluster tag of the node executing the operation. If set,
+	// status and kill requests are sent to this node as other nodes don't
+	// know about the operation
+	NodeTag string
+
+	// OnProgress, if set, is called by WaitForCompletion when the server
+	// reports progress of the operation
+	OnProgress func(*OperationProgress)
 }
 
 func (o *Operation) GetID() int64 {
 	return o.id
 }
 
+// NewOperation returns new Operation. If changes is not nil, completion of
+// the operation is tracked with change notifications and polling is used
+// only as a fallback
 func NewOperation(requestExecutor *RequestExecutor, changes func() *DatabaseChanges, conventions *DocumentConventions, id int64) *Operation {
 	return &Operation{
 		requestExecutor: requestExecutor,
-		//TBD _changes = changes;
-		conventions: conventions,
-		id:          id,
+		changes:         changes,
+		conventions:     conventions,
+		id:              id,
 	}
 }
 
-func (o *Operation) fetchOperationsStatus() (map[string]interface{}, error) {
+func (o *Operation) fetchOperationsStatus(ctx context.Context) (map[string]interface{}, error) {
 	command := o.getOperationStateCommand(o.conventions, o.id)
-	err := o.requestExecutor.ExecuteCommand(command, nil)
+	err := o.requestExecutor.ExecuteCommandCtx(ctx, command, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -46,41 +80,174 @@ func (o *Operation) fetchOperationsStatus() (map[string]interface{}, error) {
 }
 
 func (o *Operation) getOperationStateCommand(conventions *DocumentConventions, id int64) RavenCommand {
+	var command RavenCommand
 	if o.IsServerWide {
-		return NewGetServerWideOperationStateCommand(o.conventions, id)
+		command = NewGetServerWideOperationStateCommand(o.conventions, id)
+	} else {
+		command = NewGetOperationStateCommand(o.conventions, o.id)
 	}
-	return NewGetOperationStateCommand(o.conventions, o.id)
+	command.GetBase().SelectedNodeTag = o.NodeTag
+	return command
 }
 
+// WaitForCompletion waits until the operation completes on the server
 func (o *Operation) WaitForCompletion() error {
+	return o.WaitForCompletionCtx(context.Background())
+}
+
+// WaitForCompletionWithTimeout is like WaitForCompletion but returns
+// TimeoutError if the operation doesn't complete within timeout.
+// The operation keeps running on the server.
+func (o *Operation) WaitForCompletionWithTimeout(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	err := o.WaitForCompletionCtx(ctx)
+	if err == context.DeadlineExceeded {
+		return NewTimeoutError("Operation %d didn't complete within %s", o.id, timeout)
+	}
+	return err
+}
+
+// WaitForCompletionCtx is like WaitForCompletion but stops waiting when ctx
+// is done and returns ctx.Err(). The operation keeps running on the server.
+func (o *Operation) WaitForCompletionCtx(ctx context.Context) error {
+	chStates := make(chan map[string]interface{}, 16)
+	chSubscribed := make(chan struct{}, 1)
+	if o.changes != nil {
+		unsubscribe := o.subscribeToChanges(chStates, chSubscribed)
+		defer unsubscribe()
+	}
+
+	maxDelay := operationPollMaxDelay
+	delay := operationPollMinDelay
+	timer := time.NewTimer(0)
+	defer timer.Stop()
 	for {
-		status, err := o.fetchOperationsStatus()
-		if err != nil {
-			return err
+		var status map[string]interface{}
+		select {
+		case status = <-chStates:
+		case <-chSubscribed:
+			// a notification could have been sent before we subscribed
+			maxDelay = operationPollMaxDelayWithChanges
+		case <-timer.C:
+			timer.Reset(delay)
+			delay = delay * 2
+			if delay > maxDelay {
+				delay = maxDelay
+			}
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 
-		operationStatus, ok := jsonGetAsText(status, "Status")
-		if !ok {
-			return newRavenError("missing 'Status' field in response")
-		}
-		switch operationStatus {
-		case "Completed":
-			return nil
-		case "Cancelled":
-			return newOperationCancelledError("")
-		case "Faulted":
-			result, ok := status["Result"].(map[string]interface{})
-			if !ok {
-				return newRavenError("status has no 'Result' object. Status: #%v", status)
-			}
-			var exceptionResult OperationExceptionResult
-			err = structFromJSONMap(result, &exceptionResult)
+		if status == nil {
+			var err error
+			status, err = o.fetchOperationsStatus(ctx)
 			if err != nil {
 				return err
 			}
-			return exceptionDispatcherGet(exceptionResult.Message, exceptionResult.Error, exceptionResult.Type, exceptionResult.StatusCode, nil)
 		}
+		completed, err := o.processStatus(status)
+		if completed || err != nil {
+			return err
+		}
+	}
+}
+
+// subscribeToChanges sends status of the operation from change notifications
+// to chStates. Subscribing waits for confirmation from the server so it's
+// done in the background and chSubscribed is signaled when it's done
+func (o *Operation) subscribeToChanges(chStates chan map[string]interface{}, chSubscribed chan struct{}) func() {
+	var mu sync.Mutex
+	var cancel CancelFunc
+	unsubscribed := false
 
-		time.Sleep(500 * time.Millisecond)
+	go func() {
+		changes := o.changes()
+		if changes == nil {
+			return
+		}
+		cb := func(change *OperationStatusChange) {
+			select {
+			case chStates <- change.State:
+			default:
+				// polling will pick up the status
+			}
+		}
+		c, err := changes.ForOperationID(o.id, cb)
+		if err != nil {
+			return
+		}
+		mu.Lock()
+		if unsubscribed {
+			mu.Unlock()
+			c()
+			return
+		}
+		cancel = c
+		mu.Unlock()
+		chSubscribed <- struct{}{}
+	}()
+
+	return func() {
+		mu.Lock()
+		unsubscribed = true
+		c := cancel
+		mu.Unlock()
+		if c != nil {
+			c()
+		}
+	}
+}
+
+// processStatus returns true if the operation is completed and an error if
+// it failed
+func (o *Operation) processStatus(status map[string]interface{}) (bool, error) {
+	operationStatus, ok := jsonGetAsText(status, "Status")
+	if !ok {
+		return false, newRavenError("missing 'Status' field in response")
+	}
+	switch operationStatus {
+	case "Completed":
+		return true, nil
+	case "Canceled", "Cancelled":
+		return true, newOperationCancelledError("")
+	case "Faulted":
+		result, ok := status["Result"].(map[string]interface{})
+		if !ok {
+			return true, newRavenError("status has no 'Result' object. Status: #%v", status)
+		}
+		var exceptionResult OperationExceptionResult
+		err := structFromJSONMap(result, &exceptionResult)
+		if err != nil {
+			return true, err
+		}
+		return true, exceptionDispatcherGet(exceptionResult.Message, exceptionResult.Error, exceptionResult.Type, exceptionResult.StatusCode, nil)
+	}
+
+	if progress, ok := status["Progress"].(map[string]interface{}); ok && o.OnProgress != nil {
+		processed, _ := jsonGetAsInt64(progress, "Processed")
+		total, _ := jsonGetAsInt64(progress, "Total")
+		o.OnProgress(&OperationProgress{
+			Processed: processed,
+			Total:     total,
+			Values:    progress,
+		})
+	}
+	return false, nil
+}
+
+// Kill cancels the operation on the server
+func (o *Operation) Kill() error {
+	var command RavenCommand
+	if o.IsServerWide {
+		command = NewKillServerOperationCommand(o.id)
+	} else {
+		var err error
+		command, err = NewKillOperationCommand(i64toa(o.id))
+		if err != nil {
+			return err
+		}
 	}
+	command.GetBase().SelectedNodeTag = o.NodeTag
+	return o.requestExecutor.ExecuteCommand(command, nil)
 }
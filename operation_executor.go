@@ -1,6 +1,7 @@
 package ravendb
 
 import (
+	"context"
 	"net/http"
 	"strings"
 )
@@ -37,11 +38,17 @@ func (e *OperationExecutor) ForDatabase(databaseName string) *OperationExecutor
 // command and its result
 // sessionInfo can be nil
 func (e *OperationExecutor) Send(operation IOperation, sessionInfo *SessionInfo) error {
+	return e.SendCtx(context.Background(), operation, sessionInfo)
+}
+
+// SendCtx is like Send but the request to the server is cancelled
+// when ctx is done
+func (e *OperationExecutor) SendCtx(ctx context.Context, operation IOperation, sessionInfo *SessionInfo) error {
 	command, err := operation.GetCommand(e.store, e.requestExecutor.GetConventions(), e.requestExecutor.Cache)
 	if err != nil {
 		return err
 	}
-	return e.requestExecutor.ExecuteCommand(command, sessionInfo)
+	return e.requestExecutor.ExecuteCommandCtx(ctx, command, sessionInfo)
 }
 
 // sessionInfo can be nil
@@ -56,11 +63,13 @@ func (e *OperationExecutor) SendAsync(operation IOperation, sessionInfo *Session
 	}
 
 	changes := func() *DatabaseChanges {
-		return e.store.Changes("")
+		return e.store.Changes(e.databaseName)
 	}
 	result := getCommandOperationIDResult(command)
 
-	return NewOperation(e.requestExecutor, changes, e.requestExecutor.GetConventions(), result.OperationID), nil
+	op := NewOperation(e.requestExecutor, changes, e.requestExecutor.GetConventions(), result.OperationID)
+	op.NodeTag = result.OperationNodeTag
+	return op, nil
 }
 
 // Note: use SendPatchOperation() instead and check PatchOperationResult.Status
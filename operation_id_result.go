@@ -2,5 +2,6 @@ package ravendb
 
 // OperationIDResult is a result of commands like CompactDatabaseCommand
 type OperationIDResult struct {
-	OperationID int64 `json:"OperationId"`
+	OperationID      int64  `json:"OperationId"`
+	OperationNodeTag string `json:"OperationNodeTag"`
 }
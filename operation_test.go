@@ -0,0 +1,157 @@
+package ravendb
+
+import (
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationProgressAndKill(t *testing.T) {
+	srv := newFakeServer(t)
+	var nStateRequests int32
+	srv.handle("/databases/db/operations/state", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("id") {
+		case "1":
+			n := atomic.AddInt32(&nStateRequests, 1)
+			if n < 3 {
+				_, _ = w.Write([]byte(`{"Status":"InProgress","Progress":{"Processed":` + strconv.Itoa(int(n)*10) + `,"Total":30}}`))
+			} else {
+				_, _ = w.Write([]byte(`{"Status":"Completed","Result":{}}`))
+			}
+		case "2":
+			_, _ = w.Write([]byte(`{"Status":"InProgress","Progress":null}`))
+		case "3":
+			_, _ = w.Write([]byte(`{"Status":"Canceled","Result":null}`))
+		}
+	})
+	srv.respond("POST /databases/db/operations/kill", "")
+	srv.respond("POST /admin/operations/kill", "")
+	re := srv.newRequestExecutor(t, nil)
+
+	op := NewOperation(re, nil, re.GetConventions(), 1)
+	var progress []*OperationProgress
+	op.OnProgress = func(p *OperationProgress) {
+		progress = append(progress, p)
+	}
+	require.NoError(t, op.WaitForCompletion())
+	require.Len(t, progress, 2)
+	assert.Equal(t, int64(10), progress[0].Processed)
+	assert.Equal(t, int64(20), progress[1].Processed)
+	assert.Equal(t, int64(30), progress[1].Total)
+	assert.Equal(t, float64(30), progress[1].Values["Total"])
+
+	op = NewOperation(re, nil, re.GetConventions(), 2)
+	err := op.WaitForCompletionWithTimeout(300 * time.Millisecond)
+	_, ok := err.(*TimeoutError)
+	assert.True(t, ok, "expected TimeoutError, got %v", err)
+	require.NoError(t, op.Kill())
+	kill := srv.lastRequest()
+	assert.Equal(t, "/databases/db/operations/kill", kill.URL.Path)
+	assert.Equal(t, "id=2", kill.URL.RawQuery)
+
+	op = NewOperation(re, nil, re.GetConventions(), 3)
+	err = op.WaitForCompletion()
+	_, ok = err.(*OperationCancelledError)
+	assert.True(t, ok, "expected OperationCancelledError, got %v", err)
+
+	op = NewServerWideOperation(re, re.GetConventions(), 4)
+	require.NoError(t, op.Kill())
+	kill = srv.lastRequest()
+	assert.Equal(t, "/admin/operations/kill", kill.URL.Path)
+	assert.Equal(t, "id=4", kill.URL.RawQuery)
+}
+
+func TestOperationCompletesFromChanges(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	srv := newFakeServer(t)
+	// polling never sees the operation complete
+	srv.respond("/databases/db/operations/state", `{"Status":"InProgress","Progress":null}`)
+	srv.handle("/databases/db/changes", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			var cmd struct {
+				CommandID int    `json:"CommandId"`
+				Command   string `json:"Command"`
+				Param     string `json:"Param"`
+			}
+			if err := conn.ReadJSON(&cmd); err != nil {
+				return
+			}
+			confirm := []interface{}{
+				map[string]interface{}{"Type": "Confirm", "CommandId": cmd.CommandID},
+			}
+			if err := conn.WriteJSON(confirm); err != nil {
+				return
+			}
+			if cmd.Command != "watch-operation" {
+				continue
+			}
+			assert.Equal(t, "7", cmd.Param)
+			// the operation completes after the client subscribed
+			time.Sleep(100 * time.Millisecond)
+			change := []interface{}{
+				map[string]interface{}{
+					"Type": "OperationStatusChange",
+					"Value": map[string]interface{}{
+						"OperationId": 7,
+						"State": map[string]interface{}{
+							"Status":   "Completed",
+							"Progress": nil,
+							"Result":   map[string]interface{}{},
+						},
+					},
+				},
+			}
+			if err := conn.WriteJSON(change); err != nil {
+				return
+			}
+		}
+	})
+
+	re := srv.newRequestExecutor(t, nil)
+	changes := newDatabaseChanges(re, "db", nil)
+	defer changes.Close()
+
+	op := NewOperation(re, func() *DatabaseChanges { return changes }, re.GetConventions(), 7)
+	err := op.WaitForCompletionWithTimeout(5 * time.Second)
+	assert.NoError(t, err)
+}
+
+func TestOperationIsTrackedOnItsNode(t *testing.T) {
+	srvA := newFakeServer(t)
+	srvB := newFakeServer(t)
+	srvB.respond("/databases/db/operations/state", `{"Status":"Completed","Result":{}}`)
+	srvB.respond("POST /databases/db/operations/kill", "")
+
+	re := srvA.newRequestExecutor(t, nil)
+	re.setNodeSelector(NewNodeSelector(&Topology{
+		Etag: -1,
+		Nodes: []*ServerNode{
+			{URL: srvA.URL, Database: "db", ClusterTag: "A", ServerRole: ServerNodeRoleMember},
+			{URL: srvB.URL, Database: "db", ClusterTag: "B", ServerRole: ServerNodeRoleMember},
+		},
+	}))
+
+	op := NewOperation(re, nil, re.GetConventions(), 5)
+	op.NodeTag = "B"
+	require.NoError(t, op.WaitForCompletion())
+	require.NoError(t, op.Kill())
+	assert.Empty(t, srvA.getRequests())
+	require.Len(t, srvB.getRequests(), 2)
+	assert.Equal(t, "id=5", srvB.lastRequest().URL.RawQuery)
+
+	op.NodeTag = "C"
+	err := op.WaitForCompletion()
+	_, ok := err.(*RequestedNodeUnavailableError)
+	assert.True(t, ok, "expected RequestedNodeUnavailableError, got %v", err)
+}
@@ -14,6 +14,8 @@ type orderByToken struct {
 	fieldName  string
 	descending bool
 	ordering   OrderingType
+	// name of a custom sorter deployed on the server
+	sorterName string
 }
 
 func newOrderByToken(fieldName string, descending bool, ordering OrderingType) *orderByToken {
@@ -57,8 +59,22 @@ func orderByTokenCreateDescending(fieldName string, ordering OrderingType) *orde
 	return newOrderByToken(fieldName, true, ordering)
 }
 
+func orderByTokenCreateWithSorter(fieldName string, sorterName string, descending bool) *orderByToken {
+	res := newOrderByToken(fieldName, descending, OrderingTypeString)
+	res.sorterName = sorterName
+	return res
+}
+
 func (t *orderByToken) writeTo(writer *strings.Builder) error {
+	if t.sorterName != "" {
+		writer.WriteString("custom(")
+	}
 	writeQueryTokenField(writer, t.fieldName)
+	if t.sorterName != "" {
+		writer.WriteString(", '")
+		writer.WriteString(strings.Replace(t.sorterName, "'", "''", -1))
+		writer.WriteString("')")
+	}
 
 	switch t.ordering {
 	case OrderingTypeLong:
@@ -0,0 +1,23 @@
+package ravendb
+
+// RetentionPolicy describes how long backups are kept
+type RetentionPolicy struct {
+	Disabled               bool      `json:"Disabled"`
+	MinimumBackupAgeToKeep *Duration `json:"MinimumBackupAgeToKeep"`
+}
+
+// PeriodicBackupConfiguration describes a periodic backup task. Frequencies
+// are in cron format e.g. "0 2 * * 0". It's an argument to
+// UpdatePeriodicBackupOperation
+type PeriodicBackupConfiguration struct {
+	BackupConfiguration
+
+	// TaskID is 0 when creating a new task
+	TaskID                     int64            `json:"TaskId"`
+	Name                       string           `json:"Name,omitempty"`
+	Disabled                   bool             `json:"Disabled"`
+	MentorNode                 string           `json:"MentorNode,omitempty"`
+	RetentionPolicy            *RetentionPolicy `json:"RetentionPolicy"`
+	FullBackupFrequency        string           `json:"FullBackupFrequency,omitempty"`
+	IncrementalBackupFrequency string           `json:"IncrementalBackupFrequency,omitempty"`
+}
@@ -0,0 +1,40 @@
+package ravendb
+
+// BackupError describes the last error of a periodic backup
+type BackupError struct {
+	Exception string `json:"Exception"`
+	At        *Time  `json:"At"`
+}
+
+// LocalBackup describes the last backup to a local folder
+type LocalBackup struct {
+	LastFullBackup                *Time  `json:"LastFullBackup"`
+	LastIncrementalBackup         *Time  `json:"LastIncrementalBackup"`
+	FullBackupDurationInMs        *int64 `json:"FullBackupDurationInMs"`
+	IncrementalBackupDurationInMs *int64 `json:"IncrementalBackupDurationInMs"`
+	Exception                     string `json:"Exception"`
+	BackupDirectory               string `json:"BackupDirectory"`
+	FileName                      string `json:"FileName"`
+	TempFolderUsed                bool   `json:"TempFolderUsed"`
+}
+
+// PeriodicBackupStatus describes the state of a periodic backup task
+type PeriodicBackupStatus struct {
+	TaskID                        int64        `json:"TaskId"`
+	BackupType                    BackupType   `json:"BackupType"`
+	IsFull                        bool         `json:"IsFull"`
+	NodeTag                       string       `json:"NodeTag"`
+	LastFullBackup                *Time        `json:"LastFullBackup"`
+	LastIncrementalBackup         *Time        `json:"LastIncrementalBackup"`
+	LastFullBackupInternal        *Time        `json:"LastFullBackupInternal"`
+	LastIncrementalBackupInternal *Time        `json:"LastIncrementalBackupInternal"`
+	LocalBackup                   *LocalBackup `json:"LocalBackup"`
+	LastEtag                      *int64       `json:"LastEtag"`
+	LastDatabaseChangeVector      string       `json:"LastDatabaseChangeVector"`
+	FolderName                    string       `json:"FolderName"`
+	DurationInMs                  *int64       `json:"DurationInMs"`
+	Version                       int64        `json:"Version"`
+	Error                         *BackupError `json:"Error"`
+	LastOperationID               *int64       `json:"LastOperationId"`
+	IsEncrypted                   bool         `json:"IsEncrypted"`
+}
@@ -0,0 +1,72 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var _ IVoidMaintenanceOperation = &PutAnalyzersOperation{}
+
+// PutAnalyzersOperation uploads custom analyzers to the database
+type PutAnalyzersOperation struct {
+	analyzersToAdd []*AnalyzerDefinition
+
+	Command *PutAnalyzersCommand
+}
+
+// NewPutAnalyzersOperation returns an operation that uploads given analyzers
+func NewPutAnalyzersOperation(analyzersToAdd ...*AnalyzerDefinition) (*PutAnalyzersOperation, error) {
+	if len(analyzersToAdd) == 0 {
+		return nil, newIllegalArgumentError("analyzersToAdd cannot be empty")
+	}
+	return &PutAnalyzersOperation{
+		analyzersToAdd: analyzersToAdd,
+	}, nil
+}
+
+func (o *PutAnalyzersOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewPutAnalyzersCommand(conventions, o.analyzersToAdd)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
+
+var (
+	_ RavenCommand = &PutAnalyzersCommand{}
+)
+
+type PutAnalyzersCommand struct {
+	RavenCommandBase
+
+	analyzersToAdd []*AnalyzerDefinition
+}
+
+func NewPutAnalyzersCommand(conventions *DocumentConventions, analyzersToAdd []*AnalyzerDefinition) (*PutAnalyzersCommand, error) {
+	if conventions == nil {
+		return nil, newIllegalArgumentError("conventions cannot be null")
+	}
+	if err := checkAnalyzersToAdd(analyzersToAdd); err != nil {
+		return nil, err
+	}
+	cmd := &PutAnalyzersCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		analyzersToAdd: analyzersToAdd,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd, nil
+}
+
+func (c *PutAnalyzersCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/analyzers"
+
+	m := map[string]interface{}{
+		"Analyzers": c.analyzersToAdd,
+	}
+	d, err := jsonMarshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return newHttpPut(url, d)
+}
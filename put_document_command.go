@@ -29,6 +29,7 @@ func NewPutDocumentCommand(id string, changeVector *string, document map[string]
 		_changeVector: changeVector,
 		_document:     document,
 	}
+	cmd.CanCompress = true
 	return cmd
 }
 
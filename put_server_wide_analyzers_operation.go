@@ -0,0 +1,73 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var _ IServerOperation = &PutServerWideAnalyzersOperation{}
+
+// PutServerWideAnalyzersOperation uploads custom analyzers available to
+// all databases on the server
+type PutServerWideAnalyzersOperation struct {
+	analyzersToAdd []*AnalyzerDefinition
+
+	Command *PutServerWideAnalyzersCommand
+}
+
+// NewPutServerWideAnalyzersOperation returns an operation that uploads given analyzers
+func NewPutServerWideAnalyzersOperation(analyzersToAdd ...*AnalyzerDefinition) (*PutServerWideAnalyzersOperation, error) {
+	if len(analyzersToAdd) == 0 {
+		return nil, newIllegalArgumentError("analyzersToAdd cannot be empty")
+	}
+	return &PutServerWideAnalyzersOperation{
+		analyzersToAdd: analyzersToAdd,
+	}, nil
+}
+
+func (o *PutServerWideAnalyzersOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewPutServerWideAnalyzersCommand(conventions, o.analyzersToAdd)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
+
+var (
+	_ RavenCommand = &PutServerWideAnalyzersCommand{}
+)
+
+type PutServerWideAnalyzersCommand struct {
+	RavenCommandBase
+
+	analyzersToAdd []*AnalyzerDefinition
+}
+
+func NewPutServerWideAnalyzersCommand(conventions *DocumentConventions, analyzersToAdd []*AnalyzerDefinition) (*PutServerWideAnalyzersCommand, error) {
+	if conventions == nil {
+		return nil, newIllegalArgumentError("conventions cannot be null")
+	}
+	if err := checkAnalyzersToAdd(analyzersToAdd); err != nil {
+		return nil, err
+	}
+	cmd := &PutServerWideAnalyzersCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		analyzersToAdd: analyzersToAdd,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd, nil
+}
+
+func (c *PutServerWideAnalyzersCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/admin/analyzers"
+
+	m := map[string]interface{}{
+		"Analyzers": c.analyzersToAdd,
+	}
+	d, err := jsonMarshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return newHttpPut(url, d)
+}
@@ -1,11 +1,16 @@
 package ravendb
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
 	"net/http"
+	"reflect"
 )
 
 var (
-	_ RavenCommand = &QueryCommand{}
+	_ RavenCommand                  = &QueryCommand{}
+	_ ravenCommandStreamingResponse = &QueryCommand{}
 )
 
 type QueryCommand struct {
@@ -16,6 +21,10 @@ type QueryCommand struct {
 	metadataOnly     bool
 	indexEntriesOnly bool
 
+	// if set, results are decoded directly into values of this type
+	// instead of into QueryResult.Results
+	resultType reflect.Type
+
 	Result *QueryResult
 }
 
@@ -63,9 +72,59 @@ func (c *QueryCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
 }
 
 func (c *QueryCommand) SetResponse(response []byte, fromCache bool) error {
-	if len(response) == 0 {
-		return nil
+	return c.setResponseStream(bytes.NewReader(response), fromCache)
+}
+
+func (c *QueryCommand) setResponseStream(r io.Reader, fromCache bool) error {
+	var results []map[string]interface{}
+	var typedResults []*queryTypedResult
+	decodeElement := jsonDecodeMapsStream(&results)
+	if c.resultType != nil {
+		decodeElement = func(dec *json.Decoder) error {
+			result, err := decodeQueryTypedResult(dec, c.resultType)
+			if err != nil {
+				return err
+			}
+			typedResults = append(typedResults, result)
+			return nil
+		}
+	}
+	err := jsonDecodeStream(r, &c.Result, "Results", decodeElement)
+	if err != nil || c.Result == nil {
+		return err
 	}
+	c.Result.Results = results
+	c.Result.typedResults = typedResults
+	return nil
+}
 
-	return jsonUnmarshal(response, &c.Result)
+// decodeQueryTypedResult decodes a single query result into a value of
+// a given type. Only this result is buffered in memory
+func decodeQueryTypedResult(dec *json.Decoder, typ reflect.Type) (*queryTypedResult, error) {
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+	var metadataOnly struct {
+		Metadata map[string]interface{} `json:"@metadata"`
+	}
+	if err := jsonUnmarshal(raw, &metadataOnly); err != nil {
+		return nil, err
+	}
+	if metadataOnly.Metadata == nil {
+		return nil, newIllegalStateError("missing metadata")
+	}
+	res := &queryTypedResult{
+		metadata: metadataOnly.Metadata,
+	}
+	if _, ok := jsonGetAsBool(res.metadata, MetadataProjection); ok {
+		// projections are deserialized from a document
+		err := jsonUnmarshal(raw, &res.document)
+		return res, err
+	}
+	res.value = reflect.New(typ)
+	if err := jsonUnmarshal(raw, res.value.Interface()); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
@@ -19,6 +19,10 @@ type queryOperation struct {
 	startTime               time.Time
 	disableEntitiesTracking bool
 
+	// type of values in results passed to complete(), if known before
+	// the query is executed
+	resultType reflect.Type
+
 	// static  Log logger = LogFactory.getLog(queryOperation.class);
 }
 
@@ -45,7 +49,24 @@ func (o *queryOperation) createRequest() (*QueryCommand, error) {
 
 	//o.logQuery();
 
-	return NewQueryCommand(o.session.GetConventions(), o.indexQuery, o.metadataOnly, o.indexEntriesOnly)
+	cmd, err := NewQueryCommand(o.session.GetConventions(), o.indexQuery, o.metadataOnly, o.indexEntriesOnly)
+	if err != nil {
+		return nil, err
+	}
+	if o.canDecodeTypedResults() {
+		cmd.resultType = o.resultType
+	}
+	return cmd, nil
+}
+
+// canDecodeTypedResults returns true if results can be decoded directly into
+// values of resultType. Tracked entities and projections of fields need
+// documents as map[string]interface{}
+func (o *queryOperation) canDecodeTypedResults() bool {
+	if o.resultType == nil || !o.disableEntitiesTracking || o.fieldsToFetch != nil || o.metadataOnly || o.indexEntriesOnly {
+		return false
+	}
+	return fixUpStructType(reflect.PtrTo(o.resultType)) != nil
 }
 
 func (o *queryOperation) setResult(queryResult *QueryResult) error {
@@ -70,11 +91,7 @@ func (o *queryOperation) startTiming() {
 }
 
 func (o *queryOperation) logQuery() {
-	/*
-		if (logger.isInfoEnabled()) {
-			logger.info("Executing query " + _indexQuery.getQuery() + " on index " + _indexName + " in " + _session.storeIdentifier());
-		}
-	*/
+	o.session.GetConventions().getLogger().Debug("executing query", "query", o.indexQuery.query, "index", o.indexName, "database", o.session.DatabaseName)
 }
 
 func (o *queryOperation) enterQueryContext() io.Closer {
@@ -118,6 +135,8 @@ func (o *queryOperation) complete(results interface{}) error {
 
 	if !o.disableEntitiesTracking {
 		o.session.registerIncludes(queryResult.Includes)
+		o.session.registerQueryCounters(queryResult.CounterIncludes, queryResult.IncludedCounterNames)
+		o.session.registerTimeSeries(queryResult.TimeSeriesIncludes)
 	}
 
 	slice, err := makeSliceForResults(results)
@@ -125,6 +144,10 @@ func (o *queryOperation) complete(results interface{}) error {
 		return err
 	}
 
+	if queryResult.typedResults != nil {
+		return o.completeTyped(slice, queryResult.typedResults)
+	}
+
 	tmpSlice := slice
 
 	clazz := slice.Type().Elem()
@@ -154,6 +177,35 @@ func (o *queryOperation) complete(results interface{}) error {
 	return nil
 }
 
+// completeTyped is like complete for results decoded directly into values
+// of resultType. Entities are not tracked
+func (o *queryOperation) completeTyped(slice reflect.Value, typedResults []*queryTypedResult) error {
+	clazz := slice.Type().Elem()
+	if clazz != o.resultType {
+		return newIllegalStateError("query results were decoded as %s, can't return them as %s", o.resultType, clazz)
+	}
+	tmpSlice := slice
+	for _, typedResult := range typedResults {
+		metadata := typedResult.metadata
+		id, _ := jsonGetAsText(metadata, MetadataID)
+		result := typedResult.value
+		if typedResult.document != nil {
+			result = reflect.New(clazz)
+			err := queryOperationDeserialize(result.Interface(), id, typedResult.document, metadata, o.fieldsToFetch, o.disableEntitiesTracking, o.session)
+			if err != nil {
+				return newRuntimeError("Unable to read json: %s", err)
+			}
+		} else if err := o.session.setTypedQueryResult(result.Interface(), id, metadata); err != nil {
+			return newRuntimeError("Unable to read json: %s", err)
+		}
+		tmpSlice = reflect.Append(tmpSlice, result.Elem())
+	}
+	if tmpSlice != slice {
+		slice.Set(tmpSlice)
+	}
+	return nil
+}
+
 func jsonIsValueNode(v interface{}) bool {
 	switch v.(type) {
 	case string, float64, bool:
@@ -244,35 +296,7 @@ func (o *queryOperation) ensureIsAcceptableAndSaveResult(result *QueryResult) er
 	}
 	o.currentQueryResults = result
 
-	// TODO: port me when we have logger
-	/*
-	   if (logger.isInfoEnabled()) {
-	       string isStale = result.isStale() ? " stale " : " ";
-
-	       stringBuilder parameters = new stringBuilder();
-	       if (_indexQuery.getQueryParameters() != null && !_indexQuery.getQueryParameters().isEmpty()) {
-	           parameters.append("(parameters: ");
-
-	           bool first = true;
-
-	           for (Map.Entry<string, Object> parameter : _indexQuery.getQueryParameters().entrySet()) {
-	               if (!first) {
-	                   parameters.append(", ");
-	               }
-
-	               parameters.append(parameter.getKey())
-	                       .append(" = ")
-	                       .append(parameter.getValue());
-
-	               first = false;
-	           }
-
-	           parameters.append(") ");
-	       }
-
-	       logger.info("Query " + _indexQuery.getQuery() + " " + parameters.tostring() + "returned " + result.getResults().size() + isStale + "results (total index results: " + result.getTotalResults() + ")");
-	   }
-	*/
+	o.session.GetConventions().getLogger().Debug("query returned results", "query", o.indexQuery.query, "parameters", o.indexQuery.queryParameters, "results", result.numberOfResults(), "totalResults", result.TotalResults, "stale", result.IsStale)
 	return nil
 }
 
@@ -1,24 +1,51 @@
 package ravendb
 
+import "reflect"
+
 // QueryResults represents results of a query
 type QueryResult struct {
 	GenericQueryResult
+
+	// results of a query without tracking of entities, decoded directly
+	// into values of requested type. When set, Results is empty
+	typedResults []*queryTypedResult
+}
+
+// queryTypedResult is a single result decoded without going through
+// map[string]interface{}
+type queryTypedResult struct {
+	// pointer to decoded value e.g. *Foo or **Foo. Not set for projections
+	value    reflect.Value
+	metadata map[string]interface{}
+	// only set for projections
+	document map[string]interface{}
+}
+
+func (r *QueryResult) numberOfResults() int {
+	return len(r.Results) + len(r.typedResults)
 }
 
 func (r *QueryResult) createSnapshot() *QueryResult {
 	queryResult := *r
 
-	/* TBD 4.1
-	Map<String, Map<String, List<String>>> highlightings = getHighlightings();
-
-	if (highlightings != null) {
-		Map<String, Map<String, List<String>>> newHighlights = new HashMap<>();
-		for (Map.Entry<String, Map<String, List<String>>> hightlightEntry : getHighlightings().entrySet()) {
-			newHighlights.put(hightlightEntry.getKey(), new HashMap<>(hightlightEntry.getValue()));
+	if r.Highlightings != nil {
+		queryResult.Highlightings = map[string]map[string][]string{}
+		for field, highlightings := range r.Highlightings {
+			m := map[string][]string{}
+			for key, fragments := range highlightings {
+				m[key] = fragments
+			}
+			queryResult.Highlightings[field] = m
 		}
-		queryResult.setHighlightings(highlightings);
-	}*/
+	}
 
+	queryResult.Timings = r.Timings.createSnapshot()
+	if r.Explanations != nil {
+		queryResult.Explanations = map[string][]string{}
+		for key, explanations := range r.Explanations {
+			queryResult.Explanations[key] = explanations
+		}
+	}
 	queryResult.ScoreExplanations = dupMapStringString(r.ScoreExplanations)
 	queryResult.TimingsInMs = dupMapStringFloat64(r.TimingsInMs)
 	return &queryResult
@@ -14,4 +14,12 @@ type queryResultBase struct {
 	IndexName      string                   `json:"IndexName"`
 	ResultEtag     int64                    `json:"ResultEtag"`
 	LastQueryTime  *Time                    `json:"LastQueryTime"`
+
+	// counters included with include counters(), keyed by document id
+	CounterIncludes      map[string][]*CounterDetail `json:"CounterIncludes"`
+	IncludedCounterNames map[string][]string         `json:"IncludedCounterNames"`
+
+	// time series ranges included with include timeseries(), keyed by
+	// document id and time series name
+	TimeSeriesIncludes map[string]map[string][]*TimeSeriesRangeResult `json:"TimeSeriesIncludes"`
 }
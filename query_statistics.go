@@ -38,4 +38,10 @@ func (s *QueryStatistics) UpdateQueryStats(qr *QueryResult) {
 	s.ResultSize = qr.ResultSize
 	s.ResultEtag = qr.ResultEtag
 	s.ScoreExplanations = qr.ScoreExplanations
+	if len(s.TimingsInMs) == 0 && qr.Timings != nil {
+		s.TimingsInMs = qr.Timings.flatten()
+	}
+	if len(s.ScoreExplanations) == 0 && qr.Explanations != nil {
+		s.ScoreExplanations = joinExplanations(qr.Explanations)
+	}
 }
@@ -0,0 +1,53 @@
+package ravendb
+
+// QueryTimings describes time spent by the server in each stage of a query,
+// returned from a query with DocumentQuery.Timings()
+type QueryTimings struct {
+	DurationInMs int64                    `json:"DurationInMs"`
+	Timings      map[string]*QueryTimings `json:"Timings"`
+}
+
+func (t *QueryTimings) update(queryResult *QueryResult) {
+	t.DurationInMs = 0
+	t.Timings = nil
+	if queryResult.Timings == nil {
+		return
+	}
+	t.DurationInMs = queryResult.Timings.DurationInMs
+	t.Timings = queryResult.Timings.Timings
+}
+
+// flatten returns durations of all stages in the timings tree. Names of
+// nested stages are joined with "/" e.g. "Query/Lucene"
+func (t *QueryTimings) flatten() map[string]float64 {
+	res := map[string]float64{}
+	var walk func(prefix string, timings map[string]*QueryTimings)
+	walk = func(prefix string, timings map[string]*QueryTimings) {
+		for name, timing := range timings {
+			if timing == nil {
+				continue
+			}
+			name = prefix + name
+			res[name] = float64(timing.DurationInMs)
+			walk(name+"/", timing.Timings)
+		}
+	}
+	walk("", t.Timings)
+	return res
+}
+
+func (t *QueryTimings) createSnapshot() *QueryTimings {
+	if t == nil {
+		return nil
+	}
+	res := &QueryTimings{
+		DurationInMs: t.DurationInMs,
+	}
+	if t.Timings != nil {
+		res.Timings = map[string]*QueryTimings{}
+		for name, timing := range t.Timings {
+			res.Timings[name] = timing.createSnapshot()
+		}
+	}
+	return res
+}
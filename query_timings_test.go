@@ -0,0 +1,84 @@
+package ravendb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryStatisticsFromTimingsAndExplanations(t *testing.T) {
+	js := `{
+		"Results": [],
+		"Timings": {"DurationInMs": 5, "Timings": {"Query": {"DurationInMs": 4, "Timings": {"Lucene": {"DurationInMs": 3}}}, "Storage": {"DurationInMs": 1}}},
+		"Explanations": {"companies/1": ["1.0 = first", "2.0 = second"]}
+	}`
+	cmd := &QueryCommand{}
+	err := cmd.SetResponse([]byte(js), false)
+	require.NoError(t, err)
+	result := cmd.Result
+
+	stats := NewQueryStatistics()
+	stats.UpdateQueryStats(result)
+	exp := map[string]float64{
+		"Query":        4,
+		"Query/Lucene": 3,
+		"Storage":      1,
+	}
+	assert.Equal(t, exp, stats.TimingsInMs)
+	assert.Equal(t, "1.0 = first\n2.0 = second", stats.ScoreExplanations["companies/1"])
+
+	timings := &QueryTimings{}
+	timings.update(result.createSnapshot())
+	assert.Equal(t, int64(5), timings.DurationInMs)
+	assert.Equal(t, int64(3), timings.Timings["Query"].Timings["Lucene"].DurationInMs)
+
+	explanations := &Explanations{}
+	explanations.update(result)
+	assert.Equal(t, []string{"companies/1"}, explanations.GetKeys())
+	assert.Nil(t, explanations.GetExplanations("companies/2"))
+}
+
+type queryTimingsTestCompany struct {
+	ID   string
+	Name string
+}
+
+func TestProjectionSharesTimingsAndExplanations(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Results": [], "Timings": {"DurationInMs": 5}, "Explanations": {"companies/1": ["1.0 = first"]}}`))
+	}))
+	defer srv.Close()
+
+	store := NewDocumentStore([]string{srv.URL}, "db")
+	store.GetConventions().SetDisableTopologyUpdates(true)
+	require.NoError(t, store.Initialize())
+	defer store.Close()
+	session, err := store.OpenSession("")
+	require.NoError(t, err)
+	defer session.Close()
+
+	var timings *QueryTimings
+	var explanations *Explanations
+	query := session.QueryCollection("companies").
+		Search("Name", "Micro*").
+		Timings(&timings).
+		ExplainScores(&explanations)
+	projection := query.SelectFields(reflect.TypeOf(&queryTimingsTestCompany{}), "Name")
+	require.NoError(t, projection.Err())
+
+	// executing the projection fills timings and explanations of the query
+	// it was derived from
+	var companies []*queryTimingsTestCompany
+	require.NoError(t, projection.GetResults(&companies))
+	assert.Equal(t, int64(5), timings.DurationInMs)
+	assert.Equal(t, []string{"companies/1"}, explanations.GetKeys())
+
+	// explanations were already requested
+	var projectionExplanations *Explanations
+	projection = projection.ExplainScores(&projectionExplanations)
+	assert.Error(t, projection.Err())
+}
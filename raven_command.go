@@ -1,6 +1,7 @@
 package ravendb
 
 import (
+	"bytes"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -32,6 +33,13 @@ type RavenCommandBase struct {
 	// if true, can be cached
 	IsReadRequest bool
 
+	// if true, request body is compressed if enabled in DocumentConventions
+	CanCompress bool
+
+	// if set, the command is executed on the node with this cluster tag
+	// and doesn't fail over to other nodes
+	SelectedNodeTag string
+
 	FailedNodes map[*ServerNode]error
 }
 
@@ -135,6 +143,11 @@ func ravenCommand_processResponse(cmd RavenCommand, cache *httpCache, response *
 			return responseDisposeHandlingAutomatic, nil
 		}
 
+		if streamingCmd, ok := cmd.(ravenCommandStreamingResponse); ok {
+			err := c.setResponseStream(streamingCmd, cache, url, response)
+			return responseDisposeHandlingAutomatic, err
+		}
+
 		// we intentionally don't dispose the reader here, we'll be using it
 		// in the command, any associated memory will be released on context reset
 		js, err := ioutil.ReadAll(response.Body)
@@ -166,6 +179,49 @@ func (c *RavenCommandBase) cacheResponse(cache *httpCache, url string, response
 	cache.set(url, changeVector, responseJson)
 }
 
+// setResponseStream decodes response directly from the body. If the response
+// is to be cached, we need its bytes so we capture them while decoding, but
+// only up to the size of the http cache
+func (c *RavenCommandBase) setResponseStream(cmd ravenCommandStreamingResponse, cache *httpCache, url string, response *http.Response) error {
+	var body io.Reader = response.Body
+	var buf *limitedBuffer
+	if cache != nil && c.CanCache && gttpExtensionsGetEtagHeader(response) != nil {
+		maxSize := cache.maxItemSize()
+		if response.ContentLength <= int64(maxSize) {
+			buf = &limitedBuffer{limit: maxSize}
+			body = io.TeeReader(body, buf)
+		}
+	}
+	if err := cmd.setResponseStream(body, false); err != nil {
+		return err
+	}
+	if buf != nil && !buf.exceeded {
+		c.cacheResponse(cache, url, response, buf.Bytes())
+	}
+	return nil
+}
+
+// limitedBuffer is a bytes.Buffer that stops buffering once more than limit
+// bytes were written to it. Writes never fail so that it can be used with
+// io.TeeReader
+type limitedBuffer struct {
+	bytes.Buffer
+	limit    int
+	exceeded bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.exceeded {
+		return len(p), nil
+	}
+	if b.Len()+len(p) > b.limit {
+		b.exceeded = true
+		b.Buffer = bytes.Buffer{}
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
+
 // Note: unused
 func (c *RavenCommandBase) addChangeVectorIfNotNull(changeVector *string, request *http.Request) {
 	if changeVector != nil {
@@ -189,6 +245,15 @@ func getCommandOperationIDResult(cmd RavenCommand) *OperationIDResult {
 		return c.Result
 	case *DeleteByIndexCommand:
 		return c.Result
+	case *BackupCommand:
+		return c.Result
+	case *RestoreBackupCommand:
+		return c.Result
+	case *StartBackupCommand:
+		return &OperationIDResult{
+			OperationID:      c.Result.OperationID,
+			OperationNodeTag: c.Result.ResponsibleNode,
+		}
 	}
 
 	panicIf(true, "called on a command %T that doesn't return OperationIDResult", cmd)
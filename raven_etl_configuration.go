@@ -0,0 +1,16 @@
+package ravendb
+
+// RavenEtlConfiguration describes an ETL task that sends documents to
+// another RavenDB database. ConnectionStringName is a name of
+// RavenConnectionString
+type RavenEtlConfiguration struct {
+	EtlConfiguration
+	LoadRequestTimeoutInSec *int64 `json:"LoadRequestTimeoutInSec"`
+}
+
+// NewRavenEtlConfiguration returns new RavenEtlConfiguration
+func NewRavenEtlConfiguration() *RavenEtlConfiguration {
+	res := &RavenEtlConfiguration{}
+	res.EtlType = EtlTypeRaven
+	return res
+}
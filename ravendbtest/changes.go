@@ -0,0 +1,196 @@
+package ravendbtest
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/ravendb/ravendb-go-client"
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+type changesCommand struct {
+	CommandID int    `json:"CommandId"`
+	Command   string `json:"Command"`
+	Param     string `json:"Param"`
+}
+
+// changesConnection is a websocket connection opened by DatabaseChanges
+// together with document notifications it subscribed to
+type changesConnection struct {
+	conn *websocket.Conn
+	// serializes writes to conn
+	writeMu sync.Mutex
+
+	mu          sync.Mutex
+	allDocs     bool
+	docs        map[string]int
+	prefixes    map[string]int
+	collections map[string]int
+}
+
+func newChangesConnection(conn *websocket.Conn) *changesConnection {
+	return &changesConnection{
+		conn:        conn,
+		docs:        map[string]int{},
+		prefixes:    map[string]int{},
+		collections: map[string]int{},
+	}
+}
+
+func (c *changesConnection) write(v interface{}) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.conn.SetWriteDeadline(time.Now().Add(time.Second * 5)); err != nil {
+		return err
+	}
+	return c.conn.WriteJSON(v)
+}
+
+// watch updates subscriptions. Unknown commands (e.g. watching indexes or
+// counters) are confirmed but never produce notifications
+func (c *changesConnection) watch(cmd *changesCommand) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := strings.ToLower(cmd.Param)
+	update := func(m map[string]int, delta int) {
+		m[key] += delta
+		if m[key] <= 0 {
+			delete(m, key)
+		}
+	}
+	switch cmd.Command {
+	case "watch-docs":
+		c.allDocs = true
+	case "unwatch-docs":
+		c.allDocs = false
+	case "watch-doc":
+		update(c.docs, 1)
+	case "unwatch-doc":
+		update(c.docs, -1)
+	case "watch-prefix":
+		update(c.prefixes, 1)
+	case "unwatch-prefix":
+		update(c.prefixes, -1)
+	case "watch-collection":
+		update(c.collections, 1)
+	case "unwatch-collection":
+		update(c.collections, -1)
+	}
+}
+
+func (c *changesConnection) isWatching(doc *document) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.allDocs {
+		return true
+	}
+	id := strings.ToLower(doc.id)
+	if c.docs[id] > 0 || c.collections[strings.ToLower(doc.collection)] > 0 {
+		return true
+	}
+	for prefix := range c.prefixes {
+		if strings.HasPrefix(id, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// changesHub tracks changes connections of a database
+type changesHub struct {
+	mu          sync.Mutex
+	connections map[*changesConnection]struct{}
+}
+
+func newChangesHub() *changesHub {
+	return &changesHub{
+		connections: map[*changesConnection]struct{}{},
+	}
+}
+
+func (h *changesHub) add(c *changesConnection) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.connections[c] = struct{}{}
+}
+
+func (h *changesHub) remove(c *changesConnection) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.connections, c)
+}
+
+func (h *changesHub) snapshot() []*changesConnection {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	res := make([]*changesConnection, 0, len(h.connections))
+	for c := range h.connections {
+		res = append(res, c)
+	}
+	return res
+}
+
+func (h *changesHub) closeAll() {
+	for _, c := range h.snapshot() {
+		_ = c.conn.Close()
+	}
+}
+
+// publishDocumentChange notifies connections watching the document.
+// Must not be called under database lock
+func (h *changesHub) publishDocumentChange(typ ravendb.DocumentChangeTypes, doc *document) {
+	msg := []interface{}{
+		map[string]interface{}{
+			"Type": "DocumentChange",
+			"Value": map[string]interface{}{
+				"Type":           typ,
+				"Id":             doc.id,
+				"CollectionName": doc.collection,
+				"ChangeVector":   doc.changeVector,
+			},
+		},
+	}
+	for _, c := range h.snapshot() {
+		if c.isWatching(doc) {
+			// a failed connection is cleaned up by its read loop
+			_ = c.write(msg)
+		}
+	}
+}
+
+func (s *Server) handleChanges(w http.ResponseWriter, r *http.Request, db *database) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade already wrote an error response
+		return
+	}
+	c := newChangesConnection(conn)
+	db.changes.add(c)
+	defer func() {
+		db.changes.remove(c)
+		_ = conn.Close()
+	}()
+
+	for {
+		var cmd changesCommand
+		if err := conn.ReadJSON(&cmd); err != nil {
+			return
+		}
+		c.watch(&cmd)
+		confirm := []interface{}{
+			map[string]interface{}{
+				"Type":      "Confirm",
+				"CommandId": cmd.CommandID,
+			},
+		}
+		if err := c.write(confirm); err != nil {
+			return
+		}
+	}
+}
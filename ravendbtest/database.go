@@ -0,0 +1,216 @@
+package ravendbtest
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ravendb/ravendb-go-client"
+)
+
+var errConcurrency = errors.New("concurrency violation")
+
+type document struct {
+	id           string
+	collection   string
+	changeVector string
+	lastModified time.Time
+	// document without @metadata
+	data map[string]interface{}
+	// user metadata i.e. @metadata without properties managed by the server
+	metadata map[string]interface{}
+}
+
+// toJSON returns the document with @metadata, as returned by the server
+func (d *document) toJSON(metadataOnly bool) map[string]interface{} {
+	res := map[string]interface{}{}
+	if !metadataOnly {
+		for k, v := range d.data {
+			res[k] = v
+		}
+	}
+	meta := map[string]interface{}{}
+	for k, v := range d.metadata {
+		meta[k] = v
+	}
+	meta[ravendb.MetadataID] = d.id
+	meta[ravendb.MetadataCollection] = d.collection
+	meta[ravendb.MetadataChangeVector] = d.changeVector
+	meta[ravendb.MetadataLastModified] = ravendb.Time(d.lastModified).Format()
+	res[ravendb.MetadataKey] = meta
+	return res
+}
+
+type database struct {
+	name string
+	id   string
+
+	mu sync.Mutex
+	// documents keyed by lower-case id, because ids are case-insensitive
+	docs map[string]*document
+	etag int64
+	// last HiLo value handed out, keyed by tag
+	hilo map[string]int64
+
+	changes *changesHub
+}
+
+func newDatabase(name string) *database {
+	return &database{
+		name:    name,
+		id:      "ravendbtest-" + name,
+		docs:    map[string]*document{},
+		hilo:    map[string]int64{},
+		changes: newChangesHub(),
+	}
+}
+
+// cacheEtag changes whenever any document changes. Responses are tagged
+// with it so that the client can cache them. Must be called under lock
+func (db *database) cacheEtag() string {
+	return fmt.Sprintf("%s-%d", db.id, db.etag)
+}
+
+func (db *database) getDocument(id string) *document {
+	return db.docs[strings.ToLower(id)]
+}
+
+// checkChangeVector checks optimistic concurrency. Empty change vector
+// means that the document must not exist. Must be called under lock
+func (db *database) checkChangeVector(id string, changeVector *string) error {
+	if changeVector == nil {
+		return nil
+	}
+	doc := db.getDocument(id)
+	if *changeVector == "" {
+		if doc != nil {
+			return fmt.Errorf("%w: document %s already exists", errConcurrency, id)
+		}
+		return nil
+	}
+	if doc == nil || doc.changeVector != *changeVector {
+		return fmt.Errorf("%w: document %s has different change vector than %s", errConcurrency, id, *changeVector)
+	}
+	return nil
+}
+
+// put stores a document. Must be called under lock
+func (db *database) put(id string, data map[string]interface{}) *document {
+	db.etag++
+	if strings.HasSuffix(id, "/") {
+		// server-side generated id
+		id = fmt.Sprintf("%s%019d-%s", id, db.etag, nodeTag)
+	}
+	doc := &document{
+		id:           id,
+		collection:   "@empty",
+		changeVector: fmt.Sprintf("%s:%d-%s", nodeTag, db.etag, db.id),
+		lastModified: time.Now().UTC(),
+		data:         map[string]interface{}{},
+		metadata:     map[string]interface{}{},
+	}
+	if existing := db.getDocument(id); existing != nil {
+		// keep id's casing of the original document
+		doc.id = existing.id
+	}
+	for k, v := range data {
+		if k != ravendb.MetadataKey {
+			doc.data[k] = v
+		}
+	}
+	if meta, ok := data[ravendb.MetadataKey].(map[string]interface{}); ok {
+		for k, v := range meta {
+			switch k {
+			case ravendb.MetadataCollection:
+				if s, ok := v.(string); ok && s != "" {
+					doc.collection = s
+				}
+			case ravendb.MetadataID, ravendb.MetadataChangeVector, ravendb.MetadataLastModified, ravendb.MetadataFlags:
+				// managed by the server
+			default:
+				doc.metadata[k] = v
+			}
+		}
+	}
+	db.docs[strings.ToLower(id)] = doc
+	return doc
+}
+
+// delete deletes a document. Returns nil if it didn't exist.
+// Must be called under lock
+func (db *database) delete(id string) *document {
+	doc := db.getDocument(id)
+	if doc == nil {
+		return nil
+	}
+	db.etag++
+	delete(db.docs, strings.ToLower(id))
+	return doc
+}
+
+// documentsSorted returns all documents sorted by id. Must be called under lock
+func (db *database) documentsSorted() []*document {
+	res := make([]*document, 0, len(db.docs))
+	for _, doc := range db.docs {
+		res = append(res, doc)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return strings.ToLower(res[i].id) < strings.ToLower(res[j].id)
+	})
+	return res
+}
+
+// includes returns documents referenced by given paths in docs.
+// Must be called under lock
+func (db *database) includes(docs []*document, paths []string) map[string]interface{} {
+	res := map[string]interface{}{}
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		for _, path := range paths {
+			for _, v := range getFieldValues(doc, path) {
+				id, ok := v.(string)
+				if !ok {
+					continue
+				}
+				if included := db.getDocument(id); included != nil {
+					res[included.id] = included.toJSON(false)
+				}
+			}
+		}
+	}
+	return res
+}
+
+// getFieldValues returns values of a (possibly nested) field. Arrays
+// are flattened, so that a path can reference all their elements
+func getFieldValues(doc *document, path string) []interface{} {
+	if path == "id()" {
+		return []interface{}{doc.id}
+	}
+	values := []interface{}{doc.data}
+	for _, part := range strings.Split(path, ".") {
+		var next []interface{}
+		for _, v := range values {
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			fv, ok := m[part]
+			if !ok {
+				continue
+			}
+			if a, ok := fv.([]interface{}); ok {
+				next = append(next, a...)
+			} else {
+				next = append(next, fv)
+			}
+		}
+		values = next
+	}
+	return values
+}
@@ -0,0 +1,276 @@
+package ravendbtest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ravendb/ravendb-go-client"
+)
+
+const concurrencyException = "Raven.Client.Exceptions.ConcurrencyException"
+
+func (s *Server) handleGetDocuments(w http.ResponseWriter, r *http.Request, db *database) {
+	q := r.URL.Query()
+	ids := q["id"]
+	if r.Method == http.MethodPost {
+		var body struct {
+			Ids []string `json:"Ids"`
+		}
+		if err := readBody(r, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "System.ArgumentException", err.Error())
+			return
+		}
+		ids = append(ids, body.Ids...)
+	}
+	for _, name := range []string{"matches", "exclude", "counter", "timeseries"} {
+		if q.Get(name) != "" {
+			writeError(w, http.StatusNotImplemented, notSupportedException, name+" is not supported by the fake server")
+			return
+		}
+	}
+	metadataOnly := q.Get("metadataOnly") == "true"
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var docs []*document
+	nextPageStart := 0
+	if len(ids) > 0 {
+		for _, id := range ids {
+			docs = append(docs, db.getDocument(id))
+		}
+		if len(ids) == 1 && docs[0] == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+	} else {
+		start, _ := strconv.Atoi(q.Get("start"))
+		pageSize, err := strconv.Atoi(q.Get("pageSize"))
+		if err != nil || pageSize <= 0 {
+			pageSize = int(^uint(0) >> 1)
+		}
+		startAfter := strings.ToLower(q.Get("startAfter"))
+		prefix := strings.ToLower(q.Get("startsWith"))
+		skipped := 0
+		for _, doc := range db.documentsSorted() {
+			id := strings.ToLower(doc.id)
+			if !strings.HasPrefix(id, prefix) || (startAfter != "" && id <= startAfter) {
+				continue
+			}
+			if skipped < start {
+				skipped++
+				continue
+			}
+			if len(docs) >= pageSize {
+				break
+			}
+			docs = append(docs, doc)
+		}
+		nextPageStart = start + len(docs)
+	}
+
+	results := make([]interface{}, len(docs))
+	for i, doc := range docs {
+		if doc != nil {
+			results[i] = doc.toJSON(metadataOnly)
+		}
+	}
+	res := map[string]interface{}{
+		"Results":       results,
+		"Includes":      db.includes(docs, q["include"]),
+		"NextPageStart": nextPageStart,
+	}
+	writeCacheable(w, r, db.cacheEtag(), res)
+}
+
+func (s *Server) handleHeadDocument(w http.ResponseWriter, r *http.Request, db *database) {
+	id := r.URL.Query().Get("id")
+
+	db.mu.Lock()
+	doc := db.getDocument(id)
+	db.mu.Unlock()
+
+	if doc == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	quoted := `"` + doc.changeVector + `"`
+	w.Header().Set("ETag", quoted)
+	if r.Header.Get("If-None-Match") == quoted {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// changeVectorFromHeader returns value of If-Match header, if present
+func changeVectorFromHeader(r *http.Request) *string {
+	if _, ok := r.Header["If-Match"]; !ok {
+		return nil
+	}
+	cv := strings.Trim(r.Header.Get("If-Match"), `"`)
+	return &cv
+}
+
+func (s *Server) handlePutDocument(w http.ResponseWriter, r *http.Request, db *database) {
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "System.ArgumentException", "id is required")
+		return
+	}
+	var data map[string]interface{}
+	if err := readBody(r, &data); err != nil {
+		writeError(w, http.StatusBadRequest, "System.ArgumentException", err.Error())
+		return
+	}
+
+	db.mu.Lock()
+	if err := db.checkChangeVector(id, changeVectorFromHeader(r)); err != nil {
+		db.mu.Unlock()
+		writeError(w, http.StatusConflict, concurrencyException, err.Error())
+		return
+	}
+	doc := db.put(id, data)
+	db.mu.Unlock()
+
+	db.changes.publishDocumentChange(ravendb.DocumentChangePut, doc)
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"Id":           doc.id,
+		"ChangeVector": doc.changeVector,
+	})
+}
+
+func (s *Server) handleDeleteDocument(w http.ResponseWriter, r *http.Request, db *database) {
+	id := r.URL.Query().Get("id")
+
+	db.mu.Lock()
+	if err := db.checkChangeVector(id, changeVectorFromHeader(r)); err != nil {
+		db.mu.Unlock()
+		writeError(w, http.StatusConflict, concurrencyException, err.Error())
+		return
+	}
+	doc := db.delete(id)
+	db.mu.Unlock()
+
+	if doc != nil {
+		db.changes.publishDocumentChange(ravendb.DocumentChangeDelete, doc)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type batchCommand struct {
+	ID           string                 `json:"Id"`
+	Type         string                 `json:"Type"`
+	ChangeVector *string                `json:"ChangeVector"`
+	Document     map[string]interface{} `json:"Document"`
+}
+
+// handleBatch executes PUT and DELETE commands sent by SaveChanges().
+// Like the real server, the batch is transactional
+func (s *Server) handleBatch(w http.ResponseWriter, r *http.Request, db *database) {
+	if r.Method != http.MethodPost {
+		writeRouteNotFound(w, r)
+		return
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+		writeError(w, http.StatusNotImplemented, notSupportedException, "attachments are not supported by the fake server")
+		return
+	}
+	var body struct {
+		Commands []*batchCommand `json:"Commands"`
+	}
+	if err := readBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "System.ArgumentException", err.Error())
+		return
+	}
+	for _, cmd := range body.Commands {
+		if cmd.Type != "PUT" && cmd.Type != "DELETE" {
+			msg := fmt.Sprintf("command type %s is not supported by the fake server", cmd.Type)
+			writeError(w, http.StatusNotImplemented, notSupportedException, msg)
+			return
+		}
+	}
+
+	type change struct {
+		typ ravendb.DocumentChangeTypes
+		doc *document
+	}
+	var changes []change
+	var results []interface{}
+
+	db.mu.Lock()
+	for _, cmd := range body.Commands {
+		if err := db.checkChangeVector(cmd.ID, cmd.ChangeVector); err != nil {
+			db.mu.Unlock()
+			writeError(w, http.StatusConflict, concurrencyException, err.Error())
+			return
+		}
+	}
+	for _, cmd := range body.Commands {
+		switch cmd.Type {
+		case "PUT":
+			doc := db.put(cmd.ID, cmd.Document)
+			changes = append(changes, change{ravendb.DocumentChangePut, doc})
+			results = append(results, map[string]interface{}{
+				"Type":                       "PUT",
+				ravendb.MetadataID:           doc.id,
+				ravendb.MetadataCollection:   doc.collection,
+				ravendb.MetadataChangeVector: doc.changeVector,
+				ravendb.MetadataLastModified: ravendb.Time(doc.lastModified).Format(),
+			})
+		case "DELETE":
+			doc := db.delete(cmd.ID)
+			if doc != nil {
+				changes = append(changes, change{ravendb.DocumentChangeDelete, doc})
+			}
+			results = append(results, map[string]interface{}{
+				"Type":             "DELETE",
+				ravendb.MetadataID: cmd.ID,
+				"Deleted":          doc != nil,
+			})
+		}
+	}
+	db.mu.Unlock()
+
+	for _, c := range changes {
+		db.changes.publishDocumentChange(c.typ, c.doc)
+	}
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"Results":          results,
+		"TransactionIndex": 0,
+	})
+}
+
+// hiLoBatchSize is a size of id range handed out by the fake server
+const hiLoBatchSize = 32
+
+func (s *Server) handleHiLoNext(w http.ResponseWriter, r *http.Request, db *database) {
+	q := r.URL.Query()
+	tag := q.Get("tag")
+	if tag == "" {
+		writeError(w, http.StatusBadRequest, "System.ArgumentException", "tag is required")
+		return
+	}
+	separator := q.Get("identityPartsSeparator")
+	if separator == "" {
+		separator = "/"
+	}
+
+	db.mu.Lock()
+	low := db.hilo[tag] + 1
+	high := low + hiLoBatchSize - 1
+	db.hilo[tag] = high
+	db.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"Prefix":      tag + separator,
+		"Low":         low,
+		"High":        high,
+		"LastSize":    hiLoBatchSize,
+		"ServerTag":   nodeTag,
+		"LastRangeAt": ravendb.Time(time.Now().UTC()).Format(),
+	})
+}
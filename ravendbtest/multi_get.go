@@ -0,0 +1,85 @@
+package ravendbtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+type getRequest struct {
+	URL     string            `json:"Url"`
+	Query   string            `json:"Query"`
+	Method  string            `json:"Method"`
+	Headers map[string]string `json:"Headers"`
+	Content json.RawMessage   `json:"Content"`
+}
+
+type getResponse struct {
+	Result     json.RawMessage   `json:"Result"`
+	StatusCode int               `json:"StatusCode"`
+	Headers    map[string]string `json:"Headers"`
+}
+
+// handleMultiGet executes requests batched by lazy operations by
+// dispatching them to the regular handlers
+func (s *Server) handleMultiGet(w http.ResponseWriter, r *http.Request, db *database) {
+	if r.Method != http.MethodPost {
+		writeRouteNotFound(w, r)
+		return
+	}
+	var body struct {
+		Requests []*getRequest `json:"Requests"`
+	}
+	if err := readBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "System.ArgumentException", err.Error())
+		return
+	}
+
+	prefix := "/databases/" + db.name + "/"
+	results := make([]*getResponse, 0, len(body.Requests))
+	for _, req := range body.Requests {
+		// database names are case-insensitive
+		if !strings.HasPrefix(strings.ToLower(req.URL), strings.ToLower(prefix)) {
+			// the request targets a different database or is not a database request
+			writeError(w, http.StatusBadRequest, "System.ArgumentException", "invalid url "+req.URL+" in multi_get request")
+			return
+		}
+		endpoint := req.URL[len(prefix):]
+		method := req.Method
+		if method == "" {
+			method = http.MethodGet
+		}
+		var content io.Reader
+		if len(req.Content) > 0 && string(req.Content) != "null" {
+			content = bytes.NewReader(req.Content)
+		}
+		query := req.Query
+		if query != "" && !strings.HasPrefix(query, "?") {
+			query = "?" + query
+		}
+		subRequest := httptest.NewRequest(method, req.URL+query, content)
+		for k, v := range req.Headers {
+			subRequest.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		s.serveDatabase(rec, subRequest, db, endpoint)
+
+		rsp := &getResponse{
+			StatusCode: rec.Code,
+			Headers:    map[string]string{},
+		}
+		for k := range rec.Header() {
+			rsp.Headers[k] = rec.Header().Get(k)
+		}
+		if rec.Body.Len() > 0 {
+			rsp.Result = json.RawMessage(rec.Body.Bytes())
+		}
+		results = append(results, rsp)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"Results": results,
+	})
+}
@@ -0,0 +1,768 @@
+package ravendbtest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ravendb/ravendb-go-client"
+)
+
+type queryRequest struct {
+	Query           string                 `json:"Query"`
+	QueryParameters map[string]interface{} `json:"QueryParameters"`
+	Start           int                    `json:"Start"`
+	PageSize        *int                   `json:"PageSize"`
+}
+
+func (s *Server) handleQuery(w http.ResponseWriter, r *http.Request, db *database) {
+	var req queryRequest
+	if r.Method == http.MethodPost {
+		if err := readBody(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, "System.ArgumentException", err.Error())
+			return
+		}
+	} else {
+		req.Query = r.URL.Query().Get("query")
+	}
+
+	q, err := parseQuery(req.Query)
+	if err != nil {
+		writeError(w, http.StatusNotImplemented, notSupportedException, err.Error())
+		return
+	}
+	metadataOnly := r.URL.Query().Get("metadataOnly") == "true"
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var matches []*document
+	for _, doc := range db.documentsSorted() {
+		if !q.matchesCollection(doc) {
+			continue
+		}
+		ok, err := q.where.eval(doc, req.QueryParameters)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Raven.Client.Exceptions.BadRequestException", err.Error())
+			return
+		}
+		if ok {
+			matches = append(matches, doc)
+		}
+	}
+	q.sort(matches)
+
+	total := len(matches)
+	page := matches
+	if req.Start > 0 {
+		if req.Start > len(page) {
+			req.Start = len(page)
+		}
+		page = page[req.Start:]
+	}
+	if req.PageSize != nil && *req.PageSize < len(page) {
+		page = page[:*req.PageSize]
+	}
+
+	results := make([]interface{}, 0, len(page))
+	for _, doc := range page {
+		results = append(results, doc.toJSON(metadataOnly))
+	}
+	now := ravendb.Time(time.Now().UTC())
+	res := map[string]interface{}{
+		"Results":        results,
+		"Includes":       db.includes(page, q.includes),
+		"TotalResults":   total,
+		"SkippedResults": 0,
+		"IndexName":      "Auto/" + q.collection,
+		"IsStale":        false,
+		"IndexTimestamp": now,
+		"LastQueryTime":  now,
+		"ResultEtag":     db.etag,
+		"DurationInMs":   0,
+	}
+	writeCacheable(w, r, db.cacheEtag(), res)
+}
+
+type orderByField struct {
+	field      string
+	descending bool
+}
+
+type query struct {
+	collection string
+	where      expression
+	orderBy    []orderByField
+	includes   []string
+}
+
+func (q *query) matchesCollection(doc *document) bool {
+	return q.collection == ravendb.MetadataAllDocumentsCollection || strings.EqualFold(q.collection, doc.collection)
+}
+
+func (q *query) sort(docs []*document) {
+	if len(q.orderBy) == 0 {
+		return
+	}
+	sort.SliceStable(docs, func(i, j int) bool {
+		for _, o := range q.orderBy {
+			a := firstValue(getFieldValues(docs[i], o.field))
+			b := firstValue(getFieldValues(docs[j], o.field))
+			c := compareForSort(a, b)
+			if c == 0 {
+				continue
+			}
+			if o.descending {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+}
+
+func firstValue(values []interface{}) interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	return values[0]
+}
+
+// compareForSort orders nil before numbers before strings
+func compareForSort(a, b interface{}) int {
+	rank := func(v interface{}) int {
+		switch v.(type) {
+		case nil:
+			return 0
+		case float64:
+			return 1
+		case string:
+			return 2
+		default:
+			return 3
+		}
+	}
+	if ra, rb := rank(a), rank(b); ra != rb {
+		return ra - rb
+	}
+	c, _ := compareValues(a, b)
+	return c
+}
+
+// compareValues compares numbers numerically and strings case-insensitively.
+// Returns false if values are not comparable
+func compareValues(a, b interface{}) (int, bool) {
+	switch av := a.(type) {
+	case float64:
+		bv, ok := b.(float64)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case av < bv:
+			return -1, true
+		case av > bv:
+			return 1, true
+		}
+		return 0, true
+	case string:
+		bv, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(strings.ToLower(av), strings.ToLower(bv)), true
+	case bool:
+		bv, ok := b.(bool)
+		if !ok || av != bv {
+			return 0, false
+		}
+		return 0, true
+	case nil:
+		if b != nil {
+			return 0, false
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// expression is a node of a parsed where clause
+type expression interface {
+	eval(doc *document, params map[string]interface{}) (bool, error)
+}
+
+type trueExpr struct{}
+
+func (trueExpr) eval(*document, map[string]interface{}) (bool, error) {
+	return true, nil
+}
+
+type notExpr struct {
+	expr expression
+}
+
+func (e *notExpr) eval(doc *document, params map[string]interface{}) (bool, error) {
+	ok, err := e.expr.eval(doc, params)
+	return !ok, err
+}
+
+type binaryExpr struct {
+	isAnd       bool
+	left, right expression
+}
+
+func (e *binaryExpr) eval(doc *document, params map[string]interface{}) (bool, error) {
+	ok, err := e.left.eval(doc, params)
+	if err != nil {
+		return false, err
+	}
+	if ok != e.isAnd {
+		// short-circuit: false for and, true for or
+		return ok, nil
+	}
+	return e.right.eval(doc, params)
+}
+
+// value is a literal or a query parameter
+type value struct {
+	literal   interface{}
+	parameter string
+}
+
+func (v value) resolve(params map[string]interface{}) (interface{}, error) {
+	if v.parameter == "" {
+		return v.literal, nil
+	}
+	res, ok := params[v.parameter]
+	if !ok {
+		return nil, fmt.Errorf("query parameter %s is missing", v.parameter)
+	}
+	return res, nil
+}
+
+// fieldExpr evaluates a predicate against all values of a field. Like
+// in RavenDB, a field that is an array matches if any element matches
+type fieldExpr struct {
+	field  string
+	values []value
+	match  func(fieldValue interface{}, args []interface{}) bool
+	// if true, matches when the field doesn't exist
+	matchMissing bool
+}
+
+func (e *fieldExpr) eval(doc *document, params map[string]interface{}) (bool, error) {
+	args := make([]interface{}, len(e.values))
+	for i, v := range e.values {
+		arg, err := v.resolve(params)
+		if err != nil {
+			return false, err
+		}
+		args[i] = arg
+	}
+	values := getFieldValues(doc, e.field)
+	if len(values) == 0 {
+		return e.matchMissing, nil
+	}
+	for _, fv := range values {
+		if e.match(fv, args) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func newComparison(field string, op string, v value) (*fieldExpr, error) {
+	var cmp func(c int) bool
+	switch op {
+	case "=", "==":
+		cmp = func(c int) bool { return c == 0 }
+	case "!=", "<>":
+		e := &fieldExpr{
+			field:        field,
+			values:       []value{v},
+			matchMissing: true,
+		}
+		e.match = func(fv interface{}, args []interface{}) bool {
+			c, ok := compareValues(fv, args[0])
+			return !ok || c != 0
+		}
+		return e, nil
+	case "<":
+		cmp = func(c int) bool { return c < 0 }
+	case "<=":
+		cmp = func(c int) bool { return c <= 0 }
+	case ">":
+		cmp = func(c int) bool { return c > 0 }
+	case ">=":
+		cmp = func(c int) bool { return c >= 0 }
+	default:
+		return nil, fmt.Errorf("unsupported operator %s", op)
+	}
+	e := &fieldExpr{
+		field:  field,
+		values: []value{v},
+		match: func(fv interface{}, args []interface{}) bool {
+			c, ok := compareValues(fv, args[0])
+			return ok && cmp(c)
+		},
+	}
+	return e, nil
+}
+
+func matchIn(fv interface{}, args []interface{}) bool {
+	for _, arg := range args {
+		candidates := []interface{}{arg}
+		if a, ok := arg.([]interface{}); ok {
+			candidates = a
+		}
+		for _, candidate := range candidates {
+			if c, ok := compareValues(fv, candidate); ok && c == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchBetween(fv interface{}, args []interface{}) bool {
+	c1, ok1 := compareValues(fv, args[0])
+	c2, ok2 := compareValues(fv, args[1])
+	return ok1 && ok2 && c1 >= 0 && c2 <= 0
+}
+
+func matchString(fn func(s, arg string) bool) func(interface{}, []interface{}) bool {
+	return func(fv interface{}, args []interface{}) bool {
+		s, ok := fv.(string)
+		arg, ok2 := args[0].(string)
+		return ok && ok2 && fn(strings.ToLower(s), strings.ToLower(arg))
+	}
+}
+
+// matchSearch matches if any of the terms is a word in the field.
+// Terms ending with * match by prefix
+func matchSearch(s, terms string) bool {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, term := range strings.Fields(terms) {
+		prefix := strings.HasSuffix(term, "*")
+		term = strings.TrimSuffix(term, "*")
+		for _, word := range words {
+			if word == term || (prefix && strings.HasPrefix(word, term)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchAny(interface{}, []interface{}) bool {
+	return true
+}
+
+// parseQuery parses a subset of RQL
+func parseQuery(rql string) (*query, error) {
+	p := &parser{}
+	if err := p.tokenize(rql); err != nil {
+		return nil, err
+	}
+	q := &query{
+		where: trueExpr{},
+	}
+	if !p.acceptKeyword("from") {
+		return nil, p.errorf("expected 'from'")
+	}
+	if p.peekKeyword("index") {
+		return nil, fmt.Errorf("queries on indexes are not supported by the fake server: %s", rql)
+	}
+	collection, ok := p.next()
+	if !ok || (collection.kind != tokIdent && collection.kind != tokString) {
+		return nil, p.errorf("expected collection name")
+	}
+	q.collection = collection.text
+
+	if p.acceptKeyword("where") {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		q.where = expr
+	}
+
+	if p.acceptKeyword("order") {
+		if !p.acceptKeyword("by") {
+			return nil, p.errorf("expected 'by'")
+		}
+		for {
+			field, err := p.parseField()
+			if err != nil {
+				return nil, err
+			}
+			o := orderByField{field: field}
+			if p.acceptKeyword("as") {
+				// ordering type e.g. "as long", we compare by JSON type
+				p.next()
+			}
+			if p.acceptKeyword("desc") || p.acceptKeyword("descending") {
+				o.descending = true
+			} else if !p.acceptKeyword("asc") {
+				p.acceptKeyword("ascending")
+			}
+			q.orderBy = append(q.orderBy, o)
+			if !p.accept(tokComma) {
+				break
+			}
+		}
+	}
+
+	if p.acceptKeyword("include") {
+		for {
+			path, err := p.parseField()
+			if err != nil {
+				return nil, err
+			}
+			q.includes = append(q.includes, path)
+			if !p.accept(tokComma) {
+				break
+			}
+		}
+	}
+
+	if tok, ok := p.peek(); ok {
+		return nil, fmt.Errorf("'%s' is not supported by the fake server: %s", tok.text, rql)
+	}
+	return q, nil
+}
+
+type tokenKind int
+
+const (
+	tokIdent tokenKind = iota
+	tokString
+	tokNumber
+	tokParameter
+	tokOperator
+	tokOpenParen
+	tokCloseParen
+	tokComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	if tok, ok := p.peek(); ok {
+		return fmt.Errorf("%s at '%s'", msg, tok.text)
+	}
+	return fmt.Errorf("%s at the end of the query", msg)
+}
+
+func isIdentRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '@' || r == '-'
+}
+
+func (p *parser) tokenize(s string) error {
+	rs := []rune(s)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			p.tokens = append(p.tokens, token{tokOpenParen, "("})
+			i++
+		case r == ')':
+			p.tokens = append(p.tokens, token{tokCloseParen, ")"})
+			i++
+		case r == ',':
+			p.tokens = append(p.tokens, token{tokComma, ","})
+			i++
+		case r == '\'' || r == '"':
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(rs) && rs[j] != r; j++ {
+				if rs[j] == '\\' && j+1 < len(rs) {
+					j++
+				}
+				sb.WriteRune(rs[j])
+			}
+			if j >= len(rs) {
+				return fmt.Errorf("unterminated string in query: %s", s)
+			}
+			p.tokens = append(p.tokens, token{tokString, sb.String()})
+			i = j + 1
+		case r == '$':
+			j := i + 1
+			for j < len(rs) && isIdentRune(rs[j]) {
+				j++
+			}
+			p.tokens = append(p.tokens, token{tokParameter, string(rs[i+1 : j])})
+			i = j
+		case strings.ContainsRune("=!<>", r):
+			j := i + 1
+			for j < len(rs) && strings.ContainsRune("=!<>", rs[j]) {
+				j++
+			}
+			p.tokens = append(p.tokens, token{tokOperator, string(rs[i:j])})
+			i = j
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(rs) && unicode.IsDigit(rs[i+1])):
+			j := i + 1
+			for j < len(rs) && (unicode.IsDigit(rs[j]) || rs[j] == '.') {
+				j++
+			}
+			p.tokens = append(p.tokens, token{tokNumber, string(rs[i:j])})
+			i = j
+		case isIdentRune(r):
+			j := i + 1
+			for j < len(rs) && isIdentRune(rs[j]) {
+				j++
+			}
+			p.tokens = append(p.tokens, token{tokIdent, string(rs[i:j])})
+			i = j
+		default:
+			return fmt.Errorf("unexpected character '%c' in query: %s", r, s)
+		}
+	}
+	return nil
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) next() (token, bool) {
+	tok, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return tok, ok
+}
+
+func (p *parser) accept(kind tokenKind) bool {
+	if tok, ok := p.peek(); ok && tok.kind == kind {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) peekKeyword(keyword string) bool {
+	tok, ok := p.peek()
+	return ok && tok.kind == tokIdent && strings.EqualFold(tok.text, keyword)
+}
+
+func (p *parser) acceptKeyword(keyword string) bool {
+	if p.peekKeyword(keyword) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// parseField parses a field name, which can be id() or a quoted name
+func (p *parser) parseField() (string, error) {
+	tok, ok := p.next()
+	if !ok || (tok.kind != tokIdent && tok.kind != tokString) {
+		p.pos--
+		return "", p.errorf("expected field name")
+	}
+	if tok.kind == tokIdent && strings.EqualFold(tok.text, "id") && p.accept(tokOpenParen) {
+		if !p.accept(tokCloseParen) {
+			return "", p.errorf("expected ')'")
+		}
+		return "id()", nil
+	}
+	return tok.text, nil
+}
+
+func (p *parser) parseValue() (value, error) {
+	tok, ok := p.next()
+	if !ok {
+		return value{}, p.errorf("expected value")
+	}
+	switch tok.kind {
+	case tokParameter:
+		return value{parameter: tok.text}, nil
+	case tokString:
+		return value{literal: tok.text}, nil
+	case tokNumber:
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return value{}, err
+		}
+		return value{literal: f}, nil
+	case tokIdent:
+		switch strings.ToLower(tok.text) {
+		case "true":
+			return value{literal: true}, nil
+		case "false":
+			return value{literal: false}, nil
+		case "null":
+			return value{literal: nil}, nil
+		}
+	}
+	p.pos--
+	return value{}, p.errorf("expected value")
+}
+
+func (p *parser) parseOr() (expression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (expression, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("and") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpr{isAnd: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (expression, error) {
+	if p.acceptKeyword("not") {
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{expr: expr}, nil
+	}
+	if p.accept(tokOpenParen) {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(tokCloseParen) {
+			return nil, p.errorf("expected ')'")
+		}
+		return expr, nil
+	}
+	if p.acceptKeyword("true") {
+		return trueExpr{}, nil
+	}
+	return p.parseAtom()
+}
+
+// functions taking a field and a value
+var stringFunctions = map[string]func(s, arg string) bool{
+	"startswith": strings.HasPrefix,
+	"endswith":   strings.HasSuffix,
+	"search":     matchSearch,
+}
+
+func (p *parser) parseAtom() (expression, error) {
+	tok, ok := p.peek()
+	if !ok {
+		return nil, p.errorf("expected condition")
+	}
+	fn := strings.ToLower(tok.text)
+	if tok.kind == tokIdent && len(p.tokens) > p.pos+1 && p.tokens[p.pos+1].kind == tokOpenParen && fn != "id" {
+		p.pos += 2
+		field, err := p.parseField()
+		if err != nil {
+			return nil, err
+		}
+		var expr *fieldExpr
+		if fn == "exists" {
+			expr = &fieldExpr{field: field, match: matchAny}
+		} else if match, ok := stringFunctions[fn]; ok {
+			if !p.accept(tokComma) {
+				return nil, p.errorf("expected ','")
+			}
+			v, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			expr = &fieldExpr{field: field, values: []value{v}, match: matchString(match)}
+		} else {
+			return nil, fmt.Errorf("function %s() is not supported by the fake server", tok.text)
+		}
+		if !p.accept(tokCloseParen) {
+			return nil, p.errorf("expected ')'")
+		}
+		return expr, nil
+	}
+
+	field, err := p.parseField()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.acceptKeyword("in") {
+		if !p.accept(tokOpenParen) {
+			return nil, p.errorf("expected '('")
+		}
+		expr := &fieldExpr{field: field, match: matchIn}
+		for {
+			v, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			expr.values = append(expr.values, v)
+			if !p.accept(tokComma) {
+				break
+			}
+		}
+		if !p.accept(tokCloseParen) {
+			return nil, p.errorf("expected ')'")
+		}
+		return expr, nil
+	}
+
+	if p.acceptKeyword("between") {
+		from, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		if !p.acceptKeyword("and") {
+			return nil, p.errorf("expected 'and'")
+		}
+		to, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return &fieldExpr{field: field, values: []value{from, to}, match: matchBetween}, nil
+	}
+
+	op, ok := p.next()
+	if !ok || op.kind != tokOperator {
+		p.pos--
+		return nil, p.errorf("expected operator")
+	}
+	v, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	return newComparison(field, op.text, v)
+}
@@ -0,0 +1,270 @@
+// Package ravendbtest provides an in-process fake of RavenDB server for
+// unit tests of code that uses ravendb.DocumentStore.
+//
+// The fake implements enough of the HTTP protocol for DocumentStore,
+// DocumentSession and DatabaseChanges to work against it:
+//
+//   - database topology
+//   - loading, storing and deleting documents (including batches sent by
+//     DocumentSession.SaveChanges() and HiLo id generation)
+//   - multi_get requests used by lazy operations
+//   - simple queries over collections (see Server for supported RQL)
+//   - document notifications over the changes websocket
+//
+// Databases are created on first use and everything is kept in memory.
+// Indexes, subscriptions, attachments, counters, patches and other
+// features aren't supported and return an error.
+package ravendbtest
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/ravendb/ravendb-go-client"
+)
+
+const (
+	// tag of the only node in the topology
+	nodeTag = "A"
+
+	notSupportedException = "System.NotSupportedException"
+)
+
+// Server is a fake RavenDB server listening on a local address.
+//
+// Queries support RQL of the form:
+//
+//	from <collection> [where <condition>] [order by <field> [desc], ...] [include <path>, ...]
+//
+// where condition can combine with and, or, not and parentheses:
+// comparisons (=, ==, !=, <, <=, >, >=) of a field (or id()) with a
+// parameter or a literal, field in (...), field between a and b,
+// startsWith(), endsWith(), exists(), search() and true.
+type Server struct {
+	// URL of the server, to be passed to ravendb.NewDocumentStore()
+	URL string
+
+	srv *httptest.Server
+
+	mu        sync.Mutex
+	databases map[string]*database
+}
+
+// NewServer starts a new fake server. Call Close() when done with it
+func NewServer() *Server {
+	s := &Server{
+		databases: map[string]*database{},
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close closes all connections and shuts down the server
+func (s *Server) Close() {
+	s.mu.Lock()
+	databases := make([]*database, 0, len(s.databases))
+	for _, db := range s.databases {
+		databases = append(databases, db)
+	}
+	s.mu.Unlock()
+
+	// websocket connections must be closed first because
+	// httptest.Server.Close() waits for all handlers to finish
+	for _, db := range databases {
+		db.changes.closeAll()
+	}
+	s.srv.Close()
+}
+
+// NewDocumentStore returns an initialized DocumentStore for a given
+// database on this server
+func (s *Server) NewDocumentStore(databaseName string) (*ravendb.DocumentStore, error) {
+	store := ravendb.NewDocumentStore([]string{s.URL}, databaseName)
+	if err := store.Initialize(); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// CountOfDocuments returns number of documents in a given database
+func (s *Server) CountOfDocuments(databaseName string) int {
+	db := s.getDatabase(databaseName)
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return len(db.docs)
+}
+
+func (s *Server) getDatabase(name string) *database {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := strings.ToLower(name)
+	db := s.databases[key]
+	if db == nil {
+		db = newDatabase(name)
+		s.databases[key] = db
+	}
+	return db
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+	if path == "/topology" {
+		s.handleTopology(w, r)
+		return
+	}
+
+	const prefix = "/databases/"
+	if !strings.HasPrefix(path, prefix) {
+		writeRouteNotFound(w, r)
+		return
+	}
+	path = strings.TrimPrefix(path, prefix)
+	idx := strings.IndexByte(path, '/')
+	if idx <= 0 {
+		writeRouteNotFound(w, r)
+		return
+	}
+	db := s.getDatabase(path[:idx])
+	s.serveDatabase(w, r, db, path[idx+1:])
+}
+
+func (s *Server) serveDatabase(w http.ResponseWriter, r *http.Request, db *database, endpoint string) {
+	switch endpoint {
+	case "docs":
+		switch r.Method {
+		case http.MethodGet, http.MethodPost:
+			s.handleGetDocuments(w, r, db)
+		case http.MethodHead:
+			s.handleHeadDocument(w, r, db)
+		case http.MethodPut:
+			s.handlePutDocument(w, r, db)
+		case http.MethodDelete:
+			s.handleDeleteDocument(w, r, db)
+		default:
+			writeRouteNotFound(w, r)
+		}
+	case "bulk_docs":
+		s.handleBatch(w, r, db)
+	case "multi_get":
+		s.handleMultiGet(w, r, db)
+	case "queries":
+		s.handleQuery(w, r, db)
+	case "hilo/next":
+		s.handleHiLoNext(w, r, db)
+	case "hilo/return":
+		w.WriteHeader(http.StatusNoContent)
+	case "changes":
+		s.handleChanges(w, r, db)
+	case "configuration/client":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"Etag":          0,
+			"Configuration": nil,
+		})
+	case "stats":
+		s.handleStats(w, r, db)
+	default:
+		writeRouteNotFound(w, r)
+	}
+}
+
+func (s *Server) handleTopology(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		writeError(w, http.StatusBadRequest, notSupportedException, "name is required")
+		return
+	}
+	topology := &ravendb.Topology{
+		Etag: 1,
+		Nodes: []*ravendb.ServerNode{
+			{
+				URL:        s.URL,
+				Database:   name,
+				ClusterTag: nodeTag,
+				ServerRole: ravendb.ServerNodeRoleMember,
+			},
+		},
+	}
+	writeJSON(w, http.StatusOK, topology)
+}
+
+func (s *Server) handleStats(w http.ResponseWriter, r *http.Request, db *database) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"CountOfDocuments": len(db.docs),
+		"CountOfIndexes":   0,
+		"DatabaseId":       db.id,
+		"LastDocEtag":      db.etag,
+	})
+}
+
+// readBody returns decoded JSON body of the request
+func readBody(r *http.Request, v interface{}) error {
+	if r.Body == nil {
+		return fmt.Errorf("request body is empty")
+	}
+	var body io.Reader = r.Body
+	if strings.EqualFold(r.Header.Get("Content-Encoding"), "gzip") {
+		gr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return err
+		}
+		defer gr.Close()
+		body = gr
+	}
+	d, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(d, v)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	d, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "System.InvalidOperationException", err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(d)
+}
+
+// writeError writes error in a format understood by the client.
+// typ is a full name of .NET exception e.g. Raven.Client.Exceptions.ConcurrencyException
+func writeError(w http.ResponseWriter, statusCode int, typ string, msg string) {
+	v := map[string]interface{}{
+		"Type":    typ,
+		"Message": msg,
+		"Error":   typ + ": " + msg,
+	}
+	d, _ := json.Marshal(v)
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(d)
+}
+
+func writeRouteNotFound(w http.ResponseWriter, r *http.Request) {
+	msg := fmt.Sprintf("There is no handler for path: %s %s in the fake server", r.Method, r.URL.Path)
+	writeError(w, http.StatusBadRequest, "Raven.Client.Exceptions.Routing.RouteNotFoundException", msg)
+}
+
+// writeCacheable writes JSON response tagged with the database etag so
+// that the client can cache it. Returns 304 if client has current version
+func writeCacheable(w http.ResponseWriter, r *http.Request, etag string, v interface{}) {
+	quoted := `"` + etag + `"`
+	w.Header().Set("ETag", quoted)
+	if r.Header.Get("If-None-Match") == quoted {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	writeJSON(w, http.StatusOK, v)
+}
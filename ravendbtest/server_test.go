@@ -0,0 +1,285 @@
+package ravendbtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type User struct {
+	ID      string
+	Name    string
+	Age     int
+	Tags    []string
+	Company string
+}
+
+type Company struct {
+	ID   string
+	Name string
+}
+
+func newTestStore(t *testing.T) (*Server, *ravendb.DocumentStore) {
+	srv := NewServer()
+	store, err := srv.NewDocumentStore("db")
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		store.Close()
+		srv.Close()
+	})
+	return srv, store
+}
+
+func storeUsers(t *testing.T, store *ravendb.DocumentStore) {
+	session, err := store.OpenSession("")
+	require.NoError(t, err)
+	defer session.Close()
+
+	users := []*User{
+		{ID: "users/1", Name: "John Doe", Age: 35, Tags: []string{"admin"}, Company: "companies/1"},
+		{ID: "users/2", Name: "Jane Doe", Age: 28, Tags: []string{"dev", "ops"}},
+		{ID: "users/3", Name: "Bob Smith", Age: 42},
+	}
+	for _, u := range users {
+		require.NoError(t, session.Store(u))
+	}
+	require.NoError(t, session.Store(&Company{ID: "companies/1", Name: "Acme"}))
+	require.NoError(t, session.SaveChanges())
+}
+
+func TestServerCRUD(t *testing.T) {
+	srv, store := newTestStore(t)
+	storeUsers(t, store)
+	assert.Equal(t, 4, srv.CountOfDocuments("db"))
+
+	{
+		session, err := store.OpenSession("")
+		require.NoError(t, err)
+
+		var u *User
+		require.NoError(t, session.Load(&u, "USERS/1"))
+		require.NotNil(t, u)
+		assert.Equal(t, "John Doe", u.Name)
+		assert.Equal(t, []string{"admin"}, u.Tags)
+
+		meta, err := session.Advanced().GetMetadataFor(u)
+		require.NoError(t, err)
+		collection, _ := meta.Get(ravendb.MetadataCollection)
+		assert.Equal(t, "Users", collection)
+
+		var missing *User
+		require.NoError(t, session.Load(&missing, "users/100"))
+		assert.Nil(t, missing)
+
+		users := map[string]*User{}
+		require.NoError(t, session.LoadMulti(users, []string{"users/2", "users/3", "users/100"}))
+		assert.Equal(t, "Jane Doe", users["users/2"].Name)
+		assert.Equal(t, "Bob Smith", users["users/3"].Name)
+		assert.Nil(t, users["users/100"])
+
+		u.Age = 36
+		require.NoError(t, session.DeleteByID("users/3", ""))
+		require.NoError(t, session.SaveChanges())
+		session.Close()
+	}
+
+	{
+		session, err := store.OpenSession("")
+		require.NoError(t, err)
+		defer session.Close()
+
+		u, err := ravendb.Load[User](session, "users/1")
+		require.NoError(t, err)
+		assert.Equal(t, 36, u.Age)
+
+		deleted, err := ravendb.Load[User](session, "users/3")
+		require.NoError(t, err)
+		assert.Nil(t, deleted)
+
+		// server-side HiLo ids
+		u2 := &User{Name: "New"}
+		require.NoError(t, session.Store(u2))
+		assert.Equal(t, "users/1-A", u2.ID)
+		require.NoError(t, session.SaveChanges())
+	}
+	assert.Equal(t, 4, srv.CountOfDocuments("db"))
+}
+
+func TestServerConcurrency(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	store := ravendb.NewDocumentStore([]string{srv.URL}, "db")
+	store.GetConventions().UseOptimisticConcurrency = true
+	require.NoError(t, store.Initialize())
+	defer store.Close()
+	storeUsers(t, store)
+
+	session1, err := store.OpenSession("")
+	require.NoError(t, err)
+	defer session1.Close()
+	session2, err := store.OpenSession("")
+	require.NoError(t, err)
+	defer session2.Close()
+
+	var u1, u2 *User
+	require.NoError(t, session1.Load(&u1, "users/1"))
+	require.NoError(t, session2.Load(&u2, "users/1"))
+
+	u1.Name = "first"
+	require.NoError(t, session1.SaveChanges())
+
+	u2.Name = "second"
+	err = session2.SaveChanges()
+	_, ok := err.(*ravendb.ConcurrencyError)
+	assert.True(t, ok, "expected ConcurrencyError, got %T %v", err, err)
+
+	// with optimistic concurrency new documents must not exist
+	session3, err := store.OpenSession("")
+	require.NoError(t, err)
+	defer session3.Close()
+	require.NoError(t, session3.Store(&User{ID: "users/1"}))
+	err = session3.SaveChanges()
+	assert.Error(t, err)
+}
+
+func TestServerQuery(t *testing.T) {
+	_, store := newTestStore(t)
+	storeUsers(t, store)
+
+	session, err := store.OpenSession("")
+	require.NoError(t, err)
+	defer session.Close()
+
+	var users []*User
+	q := session.QueryCollection("Users").WhereGreaterThan("Age", 30).OrderByDescending("Age")
+	require.NoError(t, q.GetResults(&users))
+	require.Len(t, users, 2)
+	assert.Equal(t, "users/3", users[0].ID)
+	assert.Equal(t, "users/1", users[1].ID)
+
+	users = nil
+	q = session.QueryCollection("Users").WhereEquals("Tags", "ops").OrElse().WhereStartsWith("Name", "bob")
+	require.NoError(t, q.GetResults(&users))
+	require.Len(t, users, 2)
+	assert.Equal(t, "users/2", users[0].ID)
+	assert.Equal(t, "users/3", users[1].ID)
+
+	users = nil
+	q = session.QueryCollection("Users").Search("Name", "doe").AndAlso().Not().WhereBetween("Age", 30, 40)
+	require.NoError(t, q.GetResults(&users))
+	require.Len(t, users, 1)
+	assert.Equal(t, "users/2", users[0].ID)
+
+	typed, err := ravendb.Query[User](session).OrderBy("Name").Skip(1).Take(1).ToList()
+	require.NoError(t, err)
+	require.Len(t, typed, 1)
+	assert.Equal(t, "Jane Doe", typed[0].Name)
+
+	n, err := session.QueryCollection("Users").WhereIn("Name", []interface{}{"john doe", "Jane Doe"}).Count()
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	// included documents are loaded into the session without a request
+	users = nil
+	q = session.QueryCollection("Users").WhereEquals("id()", "users/1").Include("Company")
+	require.NoError(t, q.GetResults(&users))
+	require.Len(t, users, 1)
+	nRequests := session.Advanced().GetNumberOfRequests()
+	var company *Company
+	require.NoError(t, session.Load(&company, users[0].Company))
+	assert.Equal(t, "Acme", company.Name)
+	assert.Equal(t, nRequests, session.Advanced().GetNumberOfRequests())
+
+	err = session.QueryIndex("Users/ByName").GetResults(&users)
+	assert.Error(t, err)
+}
+
+func TestServerLazy(t *testing.T) {
+	_, store := newTestStore(t)
+	storeUsers(t, store)
+
+	session, err := store.OpenSession("")
+	require.NoError(t, err)
+	defer session.Close()
+
+	lazyUser, err := session.Advanced().Lazily().Load("users/2")
+	require.NoError(t, err)
+	lazyQuery, err := session.QueryCollection("Users").WhereLessThan("Age", 30).Lazily()
+	require.NoError(t, err)
+
+	var u *User
+	require.NoError(t, lazyUser.GetValue(&u))
+	assert.Equal(t, "Jane Doe", u.Name)
+
+	var users []*User
+	require.NoError(t, lazyQuery.GetValue(&users))
+	require.Len(t, users, 1)
+	assert.Equal(t, "users/2", users[0].ID)
+	assert.Equal(t, 1, session.Advanced().GetNumberOfRequests())
+}
+
+func TestServerChanges(t *testing.T) {
+	srv, store := newTestStore(t)
+
+	changes := store.Changes("")
+	require.NoError(t, changes.EnsureConnectedNow())
+
+	allDocs := make(chan *ravendb.DocumentChange, 8)
+	cancelAll, err := changes.ForAllDocuments(func(change *ravendb.DocumentChange) {
+		allDocs <- change
+	})
+	require.NoError(t, err)
+
+	// ForDocument() can't share DatabaseChanges with ForAllDocuments()
+	store2, err := srv.NewDocumentStore("db")
+	require.NoError(t, err)
+	defer store2.Close()
+	changes2 := store2.Changes("")
+	require.NoError(t, changes2.EnsureConnectedNow())
+	single := make(chan *ravendb.DocumentChange, 8)
+	cancelSingle, err := changes2.ForDocument("users/2", func(change *ravendb.DocumentChange) {
+		single <- change
+	})
+	require.NoError(t, err)
+	defer cancelSingle()
+
+	storeUsers(t, store)
+
+	session, err := store.OpenSession("")
+	require.NoError(t, err)
+	require.NoError(t, session.DeleteByID("users/2", ""))
+	require.NoError(t, session.SaveChanges())
+	session.Close()
+
+	receive := func(ch chan *ravendb.DocumentChange) *ravendb.DocumentChange {
+		select {
+		case change := <-ch:
+			return change
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for a change")
+			return nil
+		}
+	}
+
+	var ids []string
+	for i := 0; i < 5; i++ {
+		ids = append(ids, receive(allDocs).ID)
+	}
+	assert.Equal(t, []string{"users/1", "users/2", "users/3", "companies/1", "users/2"}, ids)
+
+	change := receive(single)
+	assert.Equal(t, ravendb.DocumentChangePut, change.Type)
+	assert.Equal(t, "Users", change.CollectionName)
+	change = receive(single)
+	assert.Equal(t, ravendb.DocumentChangeDelete, change.Type)
+	assert.Equal(t, "users/2", change.ID)
+
+	cancelAll()
+	changes.Close()
+}
@@ -30,7 +30,15 @@ func (q *RawDocumentQuery) WaitForNonStaleResultsWithTimeout(waitTimeout time.Du
 	return q
 }
 
-//TBD 4.1  RawDocumentQuery<T> showTimings() {
+// Timings sets timings to information about time spent by the server in
+// each stage of the query. The query must contain "include timings()"
+func (q *RawDocumentQuery) Timings(timings **QueryTimings) *RawDocumentQuery {
+	if q.err != nil {
+		return q
+	}
+	q.err = q.includeTimings(timings)
+	return q
+}
 
 func (q *RawDocumentQuery) NoTracking() *RawDocumentQuery {
 	q.noTracking()
@@ -1,6 +1,7 @@
 package ravendb
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -25,27 +26,18 @@ var (
 	// proxying or tweaks each http request
 	HTTPClientPostProcessor func(*http.Client)
 
-	// if true, adds lots of logging to track bugs in request executor
+	// if true, events of request executor are printed to stdout when
+	// DocumentConventions.Logger is not set.
+	// Deprecated: set DocumentConventions.Logger instead
 	DebugLogRequestExecutor bool = false
-	DebugTopology           bool = false
+	// Deprecated: set DocumentConventions.Logger instead
+	DebugTopology bool = false
 )
 
 const (
 	goClientVersion = "4.0.0"
 )
 
-func redbg(format string, args ...interface{}) {
-	if DebugLogRequestExecutor {
-		fmt.Printf(format, args...)
-	}
-}
-
-func tdbg(format string, args ...interface{}) {
-	if DebugTopology {
-		fmt.Printf(format, args...)
-	}
-}
-
 // Note: for simplicity ClusterRequestExecutor logic is implemented in RequestExecutor
 // because Go doesn't support inheritance
 type ClusterRequestExecutor = RequestExecutor
@@ -175,7 +167,6 @@ func NewRequestExecutor(databaseName string, certificate *tls.Certificate, trust
 	if conventions == nil {
 		conventions = NewDocumentConventions()
 	}
-	redbg("NewRequestExecutor: db: %s, urls: %v, read balance: %s\n", databaseName, initialUrls, conventions.ReadBalanceBehavior)
 	res := &RequestExecutor{
 		updateDatabaseTopologySemaphore:    NewSemaphore(1),
 		updateClientConfigurationSemaphore: NewSemaphore(1),
@@ -193,9 +184,14 @@ func NewRequestExecutor(databaseName string, certificate *tls.Certificate, trust
 	// TODO: handle an error
 	// TODO: java globally caches http clients
 	res.httpClient, _ = res.createClient()
+	res.logger().Debug("created request executor", "database", databaseName, "urls", initialUrls, "readBalanceBehavior", conventions.ReadBalanceBehavior)
 	return res
 }
 
+func (re *RequestExecutor) logger() Logger {
+	return re.conventions.getLogger()
+}
+
 // GetHTTPClient returns http client for sending the requests
 func (re *RequestExecutor) GetHTTPClient() (*http.Client, error) {
 	if re.httpClient != nil {
@@ -376,10 +372,11 @@ type clusterUpdateAsyncResult struct {
 	Err error
 }
 
-func dbgPrintTopology(t *Topology) {
-	tdbg("Topology nodes: %d, etag: %d\n", len(t.Nodes), t.Etag)
+func (re *RequestExecutor) logTopology(t *Topology) {
+	logger := re.logger()
+	logger.Info("updated topology", "database", re.databaseName, "nodes", len(t.Nodes), "etag", t.Etag)
 	for _, node := range t.Nodes {
-		tdbg("  tag: %s, db: %s, role: %s, url: %s\n", node.ClusterTag, node.Database, node.ServerRole, node.URL)
+		logger.Debug("topology node", "database", re.databaseName, "tag", node.ClusterTag, "role", node.ServerRole, "url", node.URL)
 	}
 }
 
@@ -488,6 +485,7 @@ func (re *RequestExecutor) clusterUpdateTopologyAsyncWithForceUpdate(node *Serve
 		newTopology := &Topology{
 			Nodes: nodes,
 		}
+		re.logTopology(newTopology)
 
 		nodeSelector := re.getNodeSelector()
 		if nodeSelector == nil {
@@ -540,7 +538,7 @@ func (re *RequestExecutor) updateTopologyAsyncWithForceUpdate(node *ServerNode,
 			return
 		}
 		result := command.Result
-		dbgPrintTopology(result)
+		re.logTopology(result)
 		nodeSelector := re.getNodeSelector()
 		if nodeSelector == nil {
 			nodeSelector = NewNodeSelector(result)
@@ -574,7 +572,20 @@ func (re *RequestExecutor) disposeAllFailedNodesTimers() {
 
 // sessionInfo can be nil
 func (re *RequestExecutor) ExecuteCommand(command RavenCommand, sessionInfo *SessionInfo) error {
-	redbg("RequestExector.ExecuteCommand: %T\n", command)
+	return re.ExecuteCommandCtx(context.Background(), command, sessionInfo)
+}
+
+// ExecuteCommandCtx is like ExecuteCommand but stops waiting for topology
+// update, sending the request and failing over to other nodes when ctx is
+// cancelled or its deadline passes. In that case ctx.Err() is returned.
+// sessionInfo can be nil
+func (re *RequestExecutor) ExecuteCommandCtx(ctx context.Context, command RavenCommand, sessionInfo *SessionInfo) error {
+	if logger := re.logger(); isLoggerEnabled(logger) {
+		logger.Debug("executing command", "command", logCommand{command})
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	if re.isDisposed() {
 		// can happen if e.g. we create BulkInsertOperation, close the store and then call Close() on BulkInsertOperation
 		return newIllegalStateError("RequestExecutor has been disposed")
@@ -586,13 +597,17 @@ func (re *RequestExecutor) ExecuteCommand(command RavenCommand, sessionInfo *Ses
 		if err != nil {
 			return err
 		}
-		return re.Execute(currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, true, sessionInfo)
+		return re.ExecuteCtx(ctx, currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, true, sessionInfo)
 	} else {
-		return re.unlikelyExecute(command, topologyUpdate, sessionInfo)
+		return re.unlikelyExecute(ctx, command, topologyUpdate, sessionInfo)
 	}
 }
 
 func (re *RequestExecutor) chooseNodeForRequest(cmd RavenCommand, sessionInfo *SessionInfo) (*CurrentIndexAndNode, error) {
+	if nodeTag := cmd.GetBase().SelectedNodeTag; nodeTag != "" {
+		return re.getRequestedNode(nodeTag)
+	}
+
 	if !cmd.GetBase().IsReadRequest {
 		return re.getPreferredNode()
 	}
@@ -614,7 +629,7 @@ func (re *RequestExecutor) chooseNodeForRequest(cmd RavenCommand, sessionInfo *S
 	return nil, nil
 }
 
-func (re *RequestExecutor) unlikelyExecuteInner(command RavenCommand, topologyUpdate *completableFuture, sessionInfo *SessionInfo) (*completableFuture, error) {
+func (re *RequestExecutor) unlikelyExecuteInner(ctx context.Context, command RavenCommand, topologyUpdate *completableFuture, sessionInfo *SessionInfo) (*completableFuture, error) {
 
 	if topologyUpdate == nil {
 		re.mu.Lock()
@@ -630,14 +645,18 @@ func (re *RequestExecutor) unlikelyExecuteInner(command RavenCommand, topologyUp
 		re.mu.Unlock()
 	}
 
-	_, err := topologyUpdate.Get()
+	_, err := topologyUpdate.getWithContext(ctx)
 	return topologyUpdate, err
 }
 
-func (re *RequestExecutor) unlikelyExecute(command RavenCommand, topologyUpdate *completableFuture, sessionInfo *SessionInfo) error {
+func (re *RequestExecutor) unlikelyExecute(ctx context.Context, command RavenCommand, topologyUpdate *completableFuture, sessionInfo *SessionInfo) error {
 	var err error
-	topologyUpdate, err = re.unlikelyExecuteInner(command, topologyUpdate, sessionInfo)
+	topologyUpdate, err = re.unlikelyExecuteInner(ctx, command, topologyUpdate, sessionInfo)
 	if err != nil {
+		if ctx.Err() != nil {
+			// topology update is still in progress, we just stopped waiting for it
+			return err
+		}
 		re.mu.Lock()
 		if re.firstTopologyUpdateFuture == topologyUpdate {
 			re.firstTopologyUpdateFuture = nil // next request will raise it
@@ -650,7 +669,7 @@ func (re *RequestExecutor) unlikelyExecute(command RavenCommand, topologyUpdate
 	if err != nil {
 		return err
 	}
-	err = re.Execute(currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, true, sessionInfo)
+	err = re.ExecuteCtx(ctx, currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, true, sessionInfo)
 	return err
 }
 
@@ -799,13 +818,46 @@ func isNetworkTimeoutError(err error) bool {
 // Execute executes a command on a given node
 // If nodeIndex is -1, we don't know the index
 func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, command RavenCommand, shouldRetry bool, sessionInfo *SessionInfo) error {
+	return re.ExecuteCtx(context.Background(), chosenNode, nodeIndex, command, shouldRetry, sessionInfo)
+}
+
+// ExecuteCtx is like Execute but the http request and fail-over to other
+// nodes are cancelled when ctx is done
+func (re *RequestExecutor) ExecuteCtx(ctx context.Context, chosenNode *ServerNode, nodeIndex int, command RavenCommand, shouldRetry bool, sessionInfo *SessionInfo) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	// nodeIndex -1 is equivalent to Java's null
-	request, err := re.createRequest(sessionInfo, chosenNode, command)
+	request, err := re.createRequest(ctx, sessionInfo, chosenNode, command)
 	if err != nil {
 		return err
 	}
 	urlRef := request.URL.String()
 
+	// describes the outcome of the request for RequestHook
+	result := &AfterResponseEventArgs{}
+	var attempt *requestAttempt
+	if hook := re.conventions.RequestHook; hook != nil {
+		result.BeforeRequestEventArgs = &BeforeRequestEventArgs{
+			CommandType: commandTypeName(command),
+			Command:     command,
+			Database:    re.databaseName,
+			NodeTag:     chosenNode.ClusterTag,
+			Method:      request.Method,
+			URL:         urlRef,
+		}
+		ctx = hook.BeforeRequest(ctx, result.BeforeRequestEventArgs)
+		request = request.WithContext(ctx)
+		attempt = &requestAttempt{
+			hook:  hook,
+			ctx:   ctx,
+			args:  result,
+			start: time.Now(),
+		}
+		// unless it was already reported before failing over
+		defer func() { attempt.done(err) }()
+	}
+
 	cachedItem, cachedChangeVector, cachedValue := re.getFromCache(command, urlRef)
 	defer cachedItem.close()
 
@@ -816,6 +868,7 @@ func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, comman
 			if !expired &&
 				!cachedItem.getMightHaveBeenModified() &&
 				command.GetBase().CanCacheAggressively {
+				result.CacheHit = true
 				return command.SetResponse(cachedValue, true)
 			}
 		}
@@ -836,13 +889,18 @@ func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, comman
 	//sp := time.Now()
 	var response *http.Response
 	re.NumberOfServerRequests.incrementAndGet()
+	re.conventions.metrics.requestSent(commandTypeName(command))
 	if re.shouldExecuteOnAll(chosenNode, command) {
-		response, err = re.executeOnAllToFigureOutTheFastest(chosenNode, command)
+		response, err = re.executeOnAllToFigureOutTheFastest(ctx, chosenNode, command)
 	} else {
 		response, err = command.Send(re.httpClient, request)
 	}
 
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			// the caller gave up, which doesn't mean the node is down
+			return ctxErr
+		}
 		if !shouldRetry && isNetworkTimeoutError(err) {
 			return err
 		}
@@ -850,7 +908,7 @@ func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, comman
 		// but for us that propagates the wrong error to RequestExecutorTest_failsWhenServerIsOffline
 		urlRef = request.URL.String()
 		var ok bool
-		ok, err = re.handleServerDown(urlRef, chosenNode, nodeIndex, command, request, response, err, sessionInfo)
+		ok, err = re.handleServerDown(ctx, urlRef, chosenNode, nodeIndex, command, request, response, err, sessionInfo, attempt)
 		if err != nil {
 			return err
 		}
@@ -861,12 +919,14 @@ func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, comman
 	}
 
 	command.GetBase().StatusCode = response.StatusCode
+	result.StatusCode = response.StatusCode
 
 	refreshTopology := httpExtensionsGetBooleanHeader(response, headersRefreshTopology)
 	refreshClientConfiguration := httpExtensionsGetBooleanHeader(response, headersRefreshClientConfiguration)
 
 	if response.StatusCode == http.StatusNotModified {
 		cachedItem.notModified()
+		result.CacheHit = true
 
 		if command.GetBase().ResponseType == RavenCommandResponseTypeObject {
 			err = command.SetResponse(cachedValue, true)
@@ -876,7 +936,7 @@ func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, comman
 
 	var ok bool
 	if response.StatusCode >= 400 {
-		ok, err = re.handleUnsuccessfulResponse(chosenNode, nodeIndex, command, request, response, urlRef, sessionInfo, shouldRetry)
+		ok, err = re.handleUnsuccessfulResponse(ctx, chosenNode, nodeIndex, command, request, response, urlRef, sessionInfo, shouldRetry, attempt)
 		if err != nil {
 			return err
 		}
@@ -936,9 +996,19 @@ func (re *RequestExecutor) Execute(chosenNode *ServerNode, nodeIndex int, comman
 		} else {
 			clientConfiguration = newCompletableFutureAlreadyCompleted(nil)
 		}
-		result := <-topologyTask
+		var result *clusterUpdateAsyncResult
+		select {
+		case result = <-topologyTask:
+		case <-ctx.Done():
+			// the command itself has completed, the updates will finish
+			// in the background
+			return nil
+		}
 		err1 := result.Err
-		_, err2 := clientConfiguration.Get()
+		_, err2 := clientConfiguration.getWithContext(ctx)
+		if err2 != nil && ctx.Err() != nil {
+			return nil
+		}
 		if err1 != nil {
 			return err1
 		}
@@ -1003,7 +1073,7 @@ type responseAndError struct {
 	err      error
 }
 
-func (re *RequestExecutor) executeOnAllToFigureOutTheFastest(chosenNode *ServerNode, command RavenCommand) (*http.Response, error) {
+func (re *RequestExecutor) executeOnAllToFigureOutTheFastest(ctx context.Context, chosenNode *ServerNode, command RavenCommand) (*http.Response, error) {
 	// note: implementation is intentionally different than Java
 
 	var fastestWasRecorded int32 // atomic
@@ -1022,7 +1092,7 @@ func (re *RequestExecutor) executeOnAllToFigureOutTheFastest(chosenNode *ServerN
 
 		go func(nodeIndex int, node *ServerNode) {
 			var response *http.Response
-			request, err := re.createRequest(nil, node, command)
+			request, err := re.createRequest(ctx, nil, node, command)
 			if err == nil {
 				response, err = command.Send(re.httpClient, request)
 				n := atomic.AddInt32(&fastestWasRecorded, 1)
@@ -1052,6 +1122,8 @@ func (re *RequestExecutor) executeOnAllToFigureOutTheFastest(chosenNode *ServerN
 		return ret.response, ret.err
 	case <-time.After(time.Second * 15):
 		return nil, fmt.Errorf("request timed out")
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 }
 
@@ -1064,11 +1136,17 @@ func (re *RequestExecutor) getFromCache(command RavenCommand, url string) (*rele
 	return newReleaseCacheItem(nil), nil, nil
 }
 
-func (re *RequestExecutor) createRequest(sessionInfo *SessionInfo, node *ServerNode, command RavenCommand) (*http.Request, error) {
+func (re *RequestExecutor) createRequest(ctx context.Context, sessionInfo *SessionInfo, node *ServerNode, command RavenCommand) (*http.Request, error) {
 	request, err := command.CreateRequest(node)
 	if err != nil {
 		return nil, err
 	}
+	if command.GetBase().CanCompress {
+		if err = compressRequestBody(request, re.conventions.Compression); err != nil {
+			return nil, err
+		}
+	}
+	request = request.WithContext(ctx)
 	request.Header.Set(headersClientVersion, goClientVersion)
 	if sessionInfo != nil && sessionInfo.lastClusterTransactionIndex != nil {
 		request.Header.Set(lastKnownClusterTransactionIndex, strconv.FormatInt(*sessionInfo.lastClusterTransactionIndex, 10))
@@ -1077,7 +1155,9 @@ func (re *RequestExecutor) createRequest(sessionInfo *SessionInfo, node *ServerN
 	return request, err
 }
 
-func (re *RequestExecutor) handleUnsuccessfulResponse(chosenNode *ServerNode, nodeIndex int, command RavenCommand, request *http.Request, response *http.Response, url string, sessionInfo *SessionInfo, shouldRetry bool) (bool, error) {
+// attempt, if not nil, is reported to RequestHook before the request is
+// re-tried on another node
+func (re *RequestExecutor) handleUnsuccessfulResponse(ctx context.Context, chosenNode *ServerNode, nodeIndex int, command RavenCommand, request *http.Request, response *http.Response, url string, sessionInfo *SessionInfo, shouldRetry bool, attempt *requestAttempt) (bool, error) {
 	var err error
 	switch response.StatusCode {
 	case http.StatusNotFound:
@@ -1101,9 +1181,14 @@ func (re *RequestExecutor) handleUnsuccessfulResponse(chosenNode *ServerNode, no
 		}
 
 		updateFuture := re.updateTopologyAsyncWithForceUpdate(chosenNode, int(math.MaxInt32), true)
-		result := <-updateFuture
-		if result.Err != nil {
-			return false, result.Err
+		var updateResult *clusterUpdateAsyncResult
+		select {
+		case updateResult = <-updateFuture:
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+		if updateResult.Err != nil {
+			return false, updateResult.Err
 		}
 
 		var currentIndexAndNode *CurrentIndexAndNode
@@ -1111,11 +1196,12 @@ func (re *RequestExecutor) handleUnsuccessfulResponse(chosenNode *ServerNode, no
 		if err != nil {
 			return false, err
 		}
-		err = re.Execute(currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, false, sessionInfo)
+		attempt.done(nil)
+		err = re.ExecuteCtx(ctx, currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, false, sessionInfo)
 		return false, err
 	case http.StatusGatewayTimeout, http.StatusRequestTimeout,
 		http.StatusBadGateway, http.StatusServiceUnavailable:
-		ok, err := re.handleServerDown(url, chosenNode, nodeIndex, command, request, response, nil, sessionInfo)
+		ok, err := re.handleServerDown(ctx, url, chosenNode, nodeIndex, command, request, response, nil, sessionInfo, attempt)
 		return ok, err
 	case http.StatusConflict:
 		err = requestExecutorHandleConflict(response)
@@ -1130,12 +1216,21 @@ func requestExecutorHandleConflict(response *http.Response) error {
 	return exceptionDispatcherThrowError(response)
 }
 
-func (re *RequestExecutor) handleServerDown(url string, chosenNode *ServerNode, nodeIndex int, command RavenCommand, request *http.Request, response *http.Response, e error, sessionInfo *SessionInfo) (bool, error) {
+// attempt, if not nil, is reported to RequestHook before failing over
+func (re *RequestExecutor) handleServerDown(ctx context.Context, url string, chosenNode *ServerNode, nodeIndex int, command RavenCommand, request *http.Request, response *http.Response, e error, sessionInfo *SessionInfo, attempt *requestAttempt) (bool, error) {
 	if command.GetBase().FailedNodes == nil {
 		command.GetBase().FailedNodes = map[*ServerNode]error{}
 	}
 
 	re.addFailedResponseToCommand(chosenNode, command, request, response, e)
+	re.conventions.metrics.serverDownDetected()
+
+	logger := re.logger()
+	statusCode := 0
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+	logger.Warn("request failed", "command", logCommand{command}, "node", chosenNode.ClusterTag, "url", url, "status", statusCode, "error", e)
 
 	if nodeIndex < 0 {
 		// We executed request over a node not in the topology. This means no failover...
@@ -1151,6 +1246,11 @@ func (re *RequestExecutor) handleServerDown(url string, chosenNode *ServerNode,
 
 	nodeSelector.onFailedRequest(nodeIndex)
 
+	if command.GetBase().SelectedNodeTag != "" {
+		// other nodes can't execute the command
+		return false, nil
+	}
+
 	currentIndexAndNode, err := re.getPreferredNode()
 	if err != nil {
 		return false, err
@@ -1158,10 +1258,32 @@ func (re *RequestExecutor) handleServerDown(url string, chosenNode *ServerNode,
 
 	if _, ok := command.GetBase().FailedNodes[currentIndexAndNode.currentNode]; ok {
 		//we tried all the nodes...nothing left to do
+		logger.Error("request failed on all nodes", "command", logCommand{command}, "database", re.databaseName)
 		return false, nil
 	}
 
-	err = re.Execute(currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, false, sessionInfo)
+	// don't fail over to the next node if the caller is no longer interested
+	if err = ctx.Err(); err != nil {
+		return false, err
+	}
+
+	logger.Info("failing over to another node", "command", logCommand{command}, "from", chosenNode.ClusterTag, "to", currentIndexAndNode.currentNode.ClusterTag)
+	re.conventions.metrics.failover()
+	// the failed request is reported with its own outcome
+	attempt.done(command.GetBase().FailedNodes[chosenNode])
+	if hook := re.conventions.RequestHook; hook != nil {
+		hook.OnFailover(ctx, &FailoverEventArgs{
+			CommandType:   commandTypeName(command),
+			Command:       command,
+			Database:      re.databaseName,
+			FailedNodeTag: chosenNode.ClusterTag,
+			FailedURL:     url,
+			StatusCode:    statusCode,
+			Err:           e,
+			NodeTag:       currentIndexAndNode.currentNode.ClusterTag,
+		})
+	}
+	err = re.ExecuteCtx(ctx, currentIndexAndNode.currentNode, currentIndexAndNode.currentIndex, command, false, sessionInfo)
 	if err != nil {
 		return false, err
 	}
@@ -1195,7 +1317,7 @@ func (re *RequestExecutor) checkNodeStatusCallback(nodeStatus *NodeStatus) {
 
 	err := re.performHealthCheck(serverNode, idx)
 	if err != nil {
-		// TODO: logging
+		re.logger().Debug("health check failed", "node", serverNode.ClusterTag, "url", serverNode.URL, "error", err)
 		status := re.getFailedNodeTimer(nodeStatus.node)
 		if status != nil {
 			status.updateTimer()
@@ -1210,6 +1332,7 @@ func (re *RequestExecutor) checkNodeStatusCallback(nodeStatus *NodeStatus) {
 		status.Close()
 	}
 
+	re.logger().Info("node is reachable again", "node", serverNode.ClusterTag, "url", serverNode.URL)
 	nodeSelector := re.getNodeSelector()
 	if nodeSelector != nil {
 		nodeSelector.restoreNodeIndex(idx)
@@ -1323,6 +1446,11 @@ func (re *RequestExecutor) createClient() (*http.Client, error) {
 	if HTTPClientPostProcessor != nil {
 		HTTPClientPostProcessor(client)
 	}
+	if replayer := re.conventions.HTTPReplayer; replayer != nil {
+		client.Transport = replayer
+	} else if recorder := re.conventions.HTTPRecorder; recorder != nil {
+		client.Transport = recorder.wrapTransport(client.Transport)
+	}
 	return client, nil
 }
 
@@ -1344,6 +1472,15 @@ func (re *RequestExecutor) getNodeBySessionID(sessionID int) (*CurrentIndexAndNo
 	return ns.getNodeBySessionID(sessionID)
 }
 
+func (re *RequestExecutor) getRequestedNode(nodeTag string) (*CurrentIndexAndNode, error) {
+	ns, err := re.ensureNodeSelector()
+	if err != nil {
+		return nil, err
+	}
+
+	return ns.getRequestedNode(nodeTag)
+}
+
 func (re *RequestExecutor) getFastestNode() (*CurrentIndexAndNode, error) {
 	ns, err := re.ensureNodeSelector()
 	if err != nil {
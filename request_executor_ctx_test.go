@@ -0,0 +1,57 @@
+package ravendb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteCommandCtxCancelled(t *testing.T) {
+	unblock := make(chan bool)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-unblock:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(unblock)
+
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(srv.URL, "db", nil, nil, nil)
+	defer re.Close()
+
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := re.ExecuteCommandCtx(ctx, NewGetStatisticsCommand(""), nil)
+		assert.Equal(t, context.Canceled, err)
+	}
+
+	{
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+		defer cancel()
+		cmd := NewGetStatisticsCommand("")
+		err := re.ExecuteCommandCtx(ctx, cmd, nil)
+		assert.Equal(t, context.DeadlineExceeded, err)
+		// giving up on a request must not mark the node as failed
+		assert.Equal(t, 0, len(cmd.FailedNodes))
+		assert.Nil(t, re.getFailedNodeTimer(re.GetTopologyNodes()[0]))
+	}
+}
+
+func TestCompletableFutureGetWithContext(t *testing.T) {
+	f := newCompletableFuture()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := f.getWithContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	f.complete(5)
+	res, err := f.getWithContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 5, res)
+}
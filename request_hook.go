@@ -0,0 +1,93 @@
+package ravendb
+
+import (
+	"context"
+	"time"
+)
+
+// BeforeRequestEventArgs describes a request about to be sent by RequestExecutor
+type BeforeRequestEventArgs struct {
+	// name of the type of the command e.g. "GetDocumentsCommand"
+	CommandType string
+	Command     RavenCommand
+	// empty for server-wide requests
+	Database string
+	// tag of the node the request is sent to
+	NodeTag string
+	Method  string
+	URL     string
+}
+
+// AfterResponseEventArgs describes the result of a request sent by RequestExecutor
+type AfterResponseEventArgs struct {
+	*BeforeRequestEventArgs
+
+	// 0 if the server didn't respond or the response was served from
+	// aggressive cache without contacting the server
+	StatusCode int
+	// true if the response was served from http cache, either because
+	// it's aggressively cached or because the server responded with
+	// 304 Not Modified
+	CacheHit bool
+	Duration time.Duration
+	Err      error
+}
+
+// FailoverEventArgs describes failing over a request to another node
+type FailoverEventArgs struct {
+	CommandType string
+	Command     RavenCommand
+	Database    string
+	// node that failed
+	FailedNodeTag string
+	FailedURL     string
+	// 0 if the server didn't respond
+	StatusCode int
+	Err        error
+	// node the request will be sent to next
+	NodeTag string
+}
+
+// RequestHook is called by RequestExecutor for every request sent to the server
+// e.g. to emit tracing spans and metrics. Set in DocumentConventions.RequestHook.
+// Hooks are called synchronously from goroutines executing requests so
+// must be thread-safe and fast
+type RequestHook interface {
+	// BeforeRequest is called before the request is sent. The returned
+	// context is used for sending the request and is passed to AfterResponse,
+	// which allows e.g. starting a span
+	BeforeRequest(ctx context.Context, args *BeforeRequestEventArgs) context.Context
+	// AfterResponse is called after a response was received and processed or
+	// the request failed. Each failover attempt is reported as a separate request
+	AfterResponse(ctx context.Context, args *AfterResponseEventArgs)
+	// OnFailover is called when a request failed on a node and is about to
+	// be re-tried on another node
+	OnFailover(ctx context.Context, args *FailoverEventArgs)
+}
+
+// requestAttempt reports a request sent to a single node to RequestHook
+type requestAttempt struct {
+	hook     RequestHook
+	ctx      context.Context
+	args     *AfterResponseEventArgs
+	start    time.Time
+	reported bool
+}
+
+// done calls AfterResponse with the outcome of the attempt. Only the first
+// call reports it. It does nothing if attempt is nil i.e. there's no hook
+func (a *requestAttempt) done(err error) {
+	if a == nil || a.reported {
+		return
+	}
+	a.reported = true
+	a.args.Duration = time.Since(a.start)
+	a.args.Err = err
+	a.hook.AfterResponse(a.ctx, a.args)
+}
+
+// commandTypeName returns name of the type of the command, without package
+// name and pointer
+func commandTypeName(command RavenCommand) string {
+	return getShortTypeNameForEntity(command)
+}
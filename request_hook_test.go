@@ -0,0 +1,131 @@
+package ravendb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ctxKey string
+
+type recordingRequestHook struct {
+	mu        sync.Mutex
+	before    []*BeforeRequestEventArgs
+	after     []*AfterResponseEventArgs
+	failovers []*FailoverEventArgs
+}
+
+func (h *recordingRequestHook) BeforeRequest(ctx context.Context, args *BeforeRequestEventArgs) context.Context {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.before = append(h.before, args)
+	return context.WithValue(ctx, ctxKey("span"), args.CommandType)
+}
+
+func (h *recordingRequestHook) AfterResponse(ctx context.Context, args *AfterResponseEventArgs) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	// the context returned by BeforeRequest is passed back
+	if ctx.Value(ctxKey("span")) == args.CommandType {
+		h.after = append(h.after, args)
+	}
+}
+
+func (h *recordingRequestHook) OnFailover(ctx context.Context, args *FailoverEventArgs) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.failovers = append(h.failovers, args)
+}
+
+func TestRequestHook(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(headersIfNoneMatch) != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"1"`)
+		_, _ = w.Write([]byte(`{"CountOfDocuments": 5}`))
+	}))
+	defer srv.Close()
+
+	hook := &recordingRequestHook{}
+	conventions := NewDocumentConventions()
+	conventions.RequestHook = hook
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(srv.URL, "db", nil, nil, conventions)
+	defer re.Close()
+
+	for i := 0; i < 2; i++ {
+		cmd := NewGetStatisticsCommand("")
+		err := re.ExecuteCommand(cmd, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), cmd.Result.CountOfDocuments)
+	}
+
+	assert.Equal(t, 2, len(hook.before))
+	assert.Equal(t, 2, len(hook.after))
+	assert.Equal(t, 0, len(hook.failovers))
+
+	args := hook.before[0]
+	assert.Equal(t, "GetStatisticsCommand", args.CommandType)
+	assert.Equal(t, "db", args.Database)
+	assert.Equal(t, http.MethodGet, args.Method)
+	assert.Equal(t, srv.URL+"/databases/db/stats", args.URL)
+
+	first := hook.after[0]
+	assert.Equal(t, http.StatusOK, first.StatusCode)
+	assert.False(t, first.CacheHit)
+	assert.NoError(t, first.Err)
+
+	second := hook.after[1]
+	assert.Equal(t, http.StatusNotModified, second.StatusCode)
+	assert.True(t, second.CacheHit)
+}
+
+func TestRequestHookReportsFailoverAttempts(t *testing.T) {
+	srvA := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srvA.Close()
+	srvB := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"CountOfDocuments": 5}`))
+	}))
+	defer srvB.Close()
+
+	hook := &recordingRequestHook{}
+	conventions := NewDocumentConventions()
+	conventions.RequestHook = hook
+	re := RequestExecutorCreateForSingleNodeWithoutConfigurationUpdates(srvA.URL, "db", nil, nil, conventions)
+	defer re.Close()
+	re.setNodeSelector(NewNodeSelector(&Topology{
+		Etag: -1,
+		Nodes: []*ServerNode{
+			{URL: srvA.URL, Database: "db", ClusterTag: "A", ServerRole: ServerNodeRoleMember},
+			{URL: srvB.URL, Database: "db", ClusterTag: "B", ServerRole: ServerNodeRoleMember},
+		},
+	}))
+
+	cmd := NewGetStatisticsCommand("")
+	err := re.ExecuteCommand(cmd, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), cmd.Result.CountOfDocuments)
+
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	require.Equal(t, 1, len(hook.failovers))
+	assert.Equal(t, "A", hook.failovers[0].FailedNodeTag)
+	assert.Equal(t, "B", hook.failovers[0].NodeTag)
+	require.Equal(t, 2, len(hook.after))
+	failed := hook.after[0]
+	assert.Equal(t, "A", failed.NodeTag)
+	assert.Equal(t, http.StatusServiceUnavailable, failed.StatusCode)
+	assert.Error(t, failed.Err)
+	succeeded := hook.after[1]
+	assert.Equal(t, "B", succeeded.NodeTag)
+	assert.Equal(t, http.StatusOK, succeeded.StatusCode)
+	assert.NoError(t, succeeded.Err)
+}
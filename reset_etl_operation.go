@@ -0,0 +1,69 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IVoidMaintenanceOperation = &ResetEtlOperation{}
+)
+
+// ResetEtlOperation restarts a transformation of an ETL task from the
+// first document
+type ResetEtlOperation struct {
+	configurationName  string
+	transformationName string
+
+	Command *ResetEtlCommand
+}
+
+// NewResetEtlOperation returns new ResetEtlOperation
+func NewResetEtlOperation(configurationName string, transformationName string) *ResetEtlOperation {
+	return &ResetEtlOperation{
+		configurationName:  configurationName,
+		transformationName: transformationName,
+	}
+}
+
+// GetCommand returns a command
+func (o *ResetEtlOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewResetEtlCommand(o.configurationName, o.transformationName)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
+
+var _ RavenCommand = &ResetEtlCommand{}
+
+// ResetEtlCommand restarts a transformation of an ETL task
+type ResetEtlCommand struct {
+	RavenCommandBase
+
+	configurationName  string
+	transformationName string
+}
+
+// NewResetEtlCommand returns new ResetEtlCommand
+func NewResetEtlCommand(configurationName string, transformationName string) (*ResetEtlCommand, error) {
+	if configurationName == "" {
+		return nil, newIllegalArgumentError("ConfigurationName cannot be empty")
+	}
+	if transformationName == "" {
+		return nil, newIllegalArgumentError("TransformationName cannot be empty")
+	}
+	cmd := &ResetEtlCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		configurationName:  configurationName,
+		transformationName: transformationName,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd, nil
+}
+
+func (c *ResetEtlCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/etl?configurationName=" + urlUtilsEscapeDataString(c.configurationName) + "&transformationName=" + urlUtilsEscapeDataString(c.transformationName)
+	return newHttpReset(url)
+}
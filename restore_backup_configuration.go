@@ -0,0 +1,17 @@
+package ravendb
+
+// RestoreBackupConfiguration describes restoring a database from a backup
+// in a local folder. It's an argument to RestoreBackupOperation
+type RestoreBackupConfiguration struct {
+	DatabaseName string `json:"DatabaseName"`
+	// BackupLocation is a folder on the server with backup files
+	BackupLocation string `json:"BackupLocation"`
+	// LastFileNameToRestore is the last incremental backup to restore.
+	// If empty, all backups in BackupLocation are restored
+	LastFileNameToRestore    string                    `json:"LastFileNameToRestore,omitempty"`
+	DataDirectory            string                    `json:"DataDirectory,omitempty"`
+	EncryptionKey            string                    `json:"EncryptionKey,omitempty"`
+	DisableOngoingTasks      bool                      `json:"DisableOngoingTasks"`
+	SkipIndexes              bool                      `json:"SkipIndexes"`
+	BackupEncryptionSettings *BackupEncryptionSettings `json:"BackupEncryptionSettings"`
+}
@@ -0,0 +1,89 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IServerOperation = &RestoreBackupOperation{}
+)
+
+// RestoreBackupOperation creates a new database from a backup.
+// Use ServerOperationExecutor.SendAsync to wait for the restore to finish
+type RestoreBackupOperation struct {
+	configuration *RestoreBackupConfiguration
+
+	Command *RestoreBackupCommand
+}
+
+// NewRestoreBackupOperation returns new RestoreBackupOperation
+func NewRestoreBackupOperation(configuration *RestoreBackupConfiguration) *RestoreBackupOperation {
+	return &RestoreBackupOperation{
+		configuration: configuration,
+	}
+}
+
+// GetCommand returns a command
+func (o *RestoreBackupOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewRestoreBackupCommand(o.configuration)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
+
+var _ RavenCommand = &RestoreBackupCommand{}
+
+// RestoreBackupCommand creates a new database from a backup
+type RestoreBackupCommand struct {
+	RavenCommandBase
+
+	configuration *RestoreBackupConfiguration
+
+	Result *OperationIDResult
+}
+
+// NewRestoreBackupCommand returns new RestoreBackupCommand
+func NewRestoreBackupCommand(configuration *RestoreBackupConfiguration) (*RestoreBackupCommand, error) {
+	if configuration == nil {
+		return nil, newIllegalArgumentError("Configuration cannot be null")
+	}
+	if configuration.DatabaseName == "" {
+		return nil, newIllegalArgumentError("DatabaseName cannot be empty")
+	}
+	if configuration.BackupLocation == "" {
+		return nil, newIllegalArgumentError("BackupLocation cannot be empty")
+	}
+	cmd := &RestoreBackupCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		configuration: configuration,
+	}
+	return cmd, nil
+}
+
+func (c *RestoreBackupCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/admin/restore/database"
+
+	// only restoring from a local folder is supported
+	m := struct {
+		*RestoreBackupConfiguration
+		Type string `json:"Type"`
+	}{
+		RestoreBackupConfiguration: c.configuration,
+		Type:                       "Local",
+	}
+	d, err := jsonMarshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
+
+func (c *RestoreBackupCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
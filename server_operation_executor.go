@@ -1,5 +1,7 @@
 package ravendb
 
+import "context"
+
 type ServerOperationExecutor struct {
 	requestExecutor *ClusterRequestExecutor
 }
@@ -23,11 +25,17 @@ func NewServerOperationExecutor(store *DocumentStore) *ServerOperationExecutor {
 }
 
 func (e *ServerOperationExecutor) Send(operation IServerOperation) error {
+	return e.SendCtx(context.Background(), operation)
+}
+
+// SendCtx is like Send but the request to the server is cancelled
+// when ctx is done
+func (e *ServerOperationExecutor) SendCtx(ctx context.Context, operation IServerOperation) error {
 	command, err := operation.GetCommand(e.requestExecutor.GetConventions())
 	if err != nil {
 		return err
 	}
-	return e.requestExecutor.ExecuteCommand(command, nil)
+	return e.requestExecutor.ExecuteCommandCtx(ctx, command, nil)
 }
 
 func (e *ServerOperationExecutor) SendAsync(operation IServerOperation) (*Operation, error) {
@@ -40,7 +48,9 @@ func (e *ServerOperationExecutor) SendAsync(operation IServerOperation) (*Operat
 		return nil, err
 	}
 	result := getCommandOperationIDResult(command)
-	return NewServerWideOperation(requestExecutor, requestExecutor.GetConventions(), result.OperationID), nil
+	op := NewServerWideOperation(requestExecutor, requestExecutor.GetConventions(), result.OperationID)
+	op.NodeTag = result.OperationNodeTag
+	return op, nil
 }
 
 func (e *ServerOperationExecutor) Close() {
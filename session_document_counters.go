@@ -0,0 +1,326 @@
+package ravendb
+
+import (
+	"strings"
+)
+
+// countersAll is used in includes to request all counters of a document
+const countersAll = "@all_counters"
+
+// countersCacheEntry holds values of counters of a document known to the session
+type countersCacheEntry struct {
+	// true if values contains all counters of the document
+	gotAll bool
+	// nil value means that we know the counter doesn't exist
+	values map[string]*int64
+}
+
+func newCountersCacheEntry() *countersCacheEntry {
+	return &countersCacheEntry{
+		values: map[string]*int64{},
+	}
+}
+
+// SessionDocumentCounters gives access to counters of a single document.
+// Increment and Delete are sent to the server on SaveChanges()
+type SessionDocumentCounters struct {
+	session *InMemoryDocumentSessionOperations
+	docID   string
+}
+
+// CountersFor returns counters of a given entity. The entity must be
+// tracked by the session
+func (s *DocumentSession) CountersFor(entity interface{}) (*SessionDocumentCounters, error) {
+	err := checkValidEntityIn(entity, "entity")
+	if err != nil {
+		return nil, err
+	}
+	document := getDocumentInfoByEntity(s.documentsByEntity, entity)
+	if document == nil {
+		return nil, throwEntityNotInSession(entity)
+	}
+	return &SessionDocumentCounters{
+		session: s.InMemoryDocumentSessionOperations,
+		docID:   document.id,
+	}, nil
+}
+
+// CountersForDocumentID returns counters of a document with a given id
+func (s *DocumentSession) CountersForDocumentID(documentID string) (*SessionDocumentCounters, error) {
+	if stringIsBlank(documentID) {
+		return nil, newIllegalArgumentError("documentID cannot be empty")
+	}
+	return &SessionDocumentCounters{
+		session: s.InMemoryDocumentSessionOperations,
+		docID:   documentID,
+	}, nil
+}
+
+func (c *SessionDocumentCounters) isDocumentDeletedInSession() bool {
+	key := newIDTypeAndName(c.docID, CommandDelete, "")
+	if _, ok := c.session.deferredCommandsMap[key]; ok {
+		return true
+	}
+	documentInfo := c.session.documentsByID.getValue(c.docID)
+	return documentInfo != nil && c.session.deletedEntities.contains(documentInfo.entity)
+}
+
+func (c *SessionDocumentCounters) getDeferredCountersCommand() *CountersBatchCommandData {
+	key := newIDTypeAndName(c.docID, CommandCounters, "")
+	cmd, ok := c.session.deferredCommandsMap[key]
+	if !ok {
+		return nil
+	}
+	return cmd.(*CountersBatchCommandData)
+}
+
+func (c *SessionDocumentCounters) deferCounterOperation(op *CounterOperation) error {
+	cmd, err := NewCountersBatchCommandData(c.docID, []*CounterOperation{op})
+	if err != nil {
+		return err
+	}
+	c.session.Defer(cmd)
+	return nil
+}
+
+// Increment increments a counter by delta. The counter is created if it
+// doesn't exist
+func (c *SessionDocumentCounters) Increment(counter string, delta int64) error {
+	if stringIsBlank(counter) {
+		return newIllegalArgumentError("counter cannot be empty")
+	}
+	if c.isDocumentDeletedInSession() {
+		return newIllegalStateError("Can't increment counter " + counter + " of document " + c.docID + ", the document was already deleted in this session")
+	}
+
+	op := &CounterOperation{
+		Type:        CounterOperationTypeIncrement,
+		CounterName: counter,
+		Delta:       delta,
+	}
+	cmd := c.getDeferredCountersCommand()
+	if cmd == nil {
+		return c.deferCounterOperation(op)
+	}
+	if cmd.hasDelete(counter) {
+		return newIllegalStateError("Can't increment counter " + counter + " of document " + c.docID + ", there is a deferred command registered to delete a counter with the same name.")
+	}
+	cmd.counters.Operations = append(cmd.counters.Operations, op)
+	return nil
+}
+
+// Delete deletes a counter
+func (c *SessionDocumentCounters) Delete(counter string) error {
+	if stringIsBlank(counter) {
+		return newIllegalArgumentError("counter cannot be empty")
+	}
+	if c.isDocumentDeletedInSession() {
+		// counters are deleted together with the document
+		return nil
+	}
+
+	op := &CounterOperation{
+		Type:        CounterOperationTypeDelete,
+		CounterName: counter,
+	}
+	cmd := c.getDeferredCountersCommand()
+	if cmd == nil {
+		if err := c.deferCounterOperation(op); err != nil {
+			return err
+		}
+	} else {
+		if cmd.hasIncrement(counter) {
+			return newIllegalStateError("Can't delete counter " + counter + " of document " + c.docID + ", there is a deferred command registered to increment a counter with the same name.")
+		}
+		cmd.counters.Operations = append(cmd.counters.Operations, op)
+	}
+
+	if cache := c.session.countersByDocID[strings.ToLower(c.docID)]; cache != nil {
+		delete(cache.values, counter)
+	}
+	return nil
+}
+
+// returns true if we have to ask the server about the value of a counter
+func (c *SessionDocumentCounters) shouldFetch(cache *countersCacheEntry, counter string) bool {
+	document := c.session.documentsByID.getValue(c.docID)
+	if document == nil {
+		return !cache.gotAll
+	}
+	// if the document is loaded, its metadata tells us which counters it has
+	names, _ := document.metadata[MetadataCounters].([]interface{})
+	for _, name := range names {
+		if s, ok := name.(string); ok && strings.EqualFold(s, counter) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *SessionDocumentCounters) getCacheEntry() *countersCacheEntry {
+	key := strings.ToLower(c.docID)
+	cache := c.session.countersByDocID[key]
+	if cache == nil {
+		cache = newCountersCacheEntry()
+		c.session.countersByDocID[key] = cache
+	}
+	return cache
+}
+
+func (c *SessionDocumentCounters) fetch(counters []string) (*CountersDetail, error) {
+	if err := c.session.incrementRequestCount(); err != nil {
+		return nil, err
+	}
+	cmd, err := NewGetCountersCommand(c.docID, counters, false)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.session.requestExecutor.ExecuteCommand(cmd, c.session.sessionInfo); err != nil {
+		return nil, err
+	}
+	if cmd.Result == nil {
+		return &CountersDetail{}, nil
+	}
+	return cmd.Result, nil
+}
+
+// Get returns the value of a counter or nil if the counter doesn't exist
+func (c *SessionDocumentCounters) Get(counter string) (*int64, error) {
+	values, err := c.GetMulti([]string{counter})
+	if err != nil {
+		return nil, err
+	}
+	return values[counter], nil
+}
+
+// GetMulti returns values of given counters. The value is nil if the
+// counter doesn't exist
+func (c *SessionDocumentCounters) GetMulti(counters []string) (map[string]*int64, error) {
+	cache := c.getCacheEntry()
+	res := map[string]*int64{}
+	var toFetch []string
+	for _, counter := range counters {
+		if v, ok := cache.values[counter]; ok {
+			res[counter] = v
+			continue
+		}
+		if c.shouldFetch(cache, counter) {
+			toFetch = append(toFetch, counter)
+			continue
+		}
+		res[counter] = nil
+	}
+
+	if len(toFetch) > 0 {
+		details, err := c.fetch(toFetch)
+		if err != nil {
+			return nil, err
+		}
+		for _, counter := range toFetch {
+			res[counter] = nil
+		}
+		for _, detail := range details.Counters {
+			if detail == nil {
+				continue
+			}
+			v := detail.TotalValue
+			res[detail.CounterName] = &v
+		}
+	}
+
+	for counter, v := range res {
+		cache.values[counter] = v
+	}
+	return res, nil
+}
+
+// GetAll returns values of all counters of the document
+func (c *SessionDocumentCounters) GetAll() (map[string]int64, error) {
+	cache := c.getCacheEntry()
+	if !cache.gotAll {
+		details, err := c.fetch(nil)
+		if err != nil {
+			return nil, err
+		}
+		cache.values = map[string]*int64{}
+		for _, detail := range details.Counters {
+			if detail == nil {
+				continue
+			}
+			v := detail.TotalValue
+			cache.values[detail.CounterName] = &v
+		}
+		cache.gotAll = true
+	}
+
+	res := map[string]int64{}
+	for counter, v := range cache.values {
+		if v != nil {
+			res[counter] = *v
+		}
+	}
+	return res, nil
+}
+
+func (s *InMemoryDocumentSessionOperations) registerCounterDetails(cache *countersCacheEntry, details []*CounterDetail) {
+	for _, detail := range details {
+		if detail == nil {
+			continue
+		}
+		v := detail.TotalValue
+		cache.values[detail.CounterName] = &v
+	}
+}
+
+// registerCounters remembers counters included in the result of loading documents
+func (s *InMemoryDocumentSessionOperations) registerCounters(resultCounters map[string][]*CounterDetail, ids []string, countersToInclude []string, gotAll bool) {
+	if s.noTracking {
+		return
+	}
+	for _, id := range ids {
+		cache := newCountersCacheEntry()
+		cache.gotAll = gotAll
+		if !gotAll {
+			if prev := s.countersByDocID[strings.ToLower(id)]; prev != nil {
+				cache = prev
+			}
+			// counters that were requested but not returned don't exist
+			for _, counter := range countersToInclude {
+				cache.values[counter] = nil
+			}
+		}
+		s.registerCounterDetails(cache, resultCounters[id])
+		s.countersByDocID[strings.ToLower(id)] = cache
+	}
+}
+
+// registerQueryCounters remembers counters included in the result of a query.
+// An empty list of counter names means all counters of the document were included
+func (s *InMemoryDocumentSessionOperations) registerQueryCounters(resultCounters map[string][]*CounterDetail, includedCounterNames map[string][]string) {
+	for id, names := range includedCounterNames {
+		s.registerCounters(resultCounters, []string{id}, names, len(names) == 0)
+	}
+}
+
+// updateCountersFromBatchResult updates cached counter values from the
+// result of a "Counters" command in a batch
+func (s *InMemoryDocumentSessionOperations) updateCountersFromBatchResult(batchResult map[string]interface{}) error {
+	docID, _ := jsonGetAsText(batchResult, "Id")
+	if docID == "" {
+		return newIllegalStateError("Counters response is invalid. Id is missing")
+	}
+	var detail *CountersDetail
+	if err := decodeJSONAsStruct(batchResult["CountersDetail"], &detail); err != nil {
+		return err
+	}
+	key := strings.ToLower(docID)
+	cache := s.countersByDocID[key]
+	if cache == nil {
+		cache = newCountersCacheEntry()
+		s.countersByDocID[key] = cache
+	}
+	if detail != nil {
+		s.registerCounterDetails(cache, detail.Counters)
+	}
+	return nil
+}
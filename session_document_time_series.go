@@ -0,0 +1,225 @@
+package ravendb
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// SessionDocumentTimeSeries gives access to a single time series of a document.
+// Append and Delete are sent to the server on SaveChanges()
+type SessionDocumentTimeSeries struct {
+	session *InMemoryDocumentSessionOperations
+	docID   string
+	name    string
+}
+
+// TimeSeriesFor returns a time series with a given name of a document with a given id
+func (s *DocumentSession) TimeSeriesFor(documentID string, name string) (*SessionDocumentTimeSeries, error) {
+	if stringIsBlank(documentID) {
+		return nil, newIllegalArgumentError("documentID cannot be empty")
+	}
+	if stringIsBlank(name) {
+		return nil, newIllegalArgumentError("name cannot be empty")
+	}
+	return &SessionDocumentTimeSeries{
+		session: s.InMemoryDocumentSessionOperations,
+		docID:   documentID,
+		name:    name,
+	}, nil
+}
+
+// TimeSeriesForEntity returns a time series with a given name of a given entity.
+// The entity must be tracked by the session
+func (s *DocumentSession) TimeSeriesForEntity(entity interface{}, name string) (*SessionDocumentTimeSeries, error) {
+	err := checkValidEntityIn(entity, "entity")
+	if err != nil {
+		return nil, err
+	}
+	document := getDocumentInfoByEntity(s.documentsByEntity, entity)
+	if document == nil {
+		return nil, throwEntityNotInSession(entity)
+	}
+	return s.TimeSeriesFor(document.id, name)
+}
+
+func (t *SessionDocumentTimeSeries) isDocumentDeletedInSession() bool {
+	key := newIDTypeAndName(t.docID, CommandDelete, "")
+	if _, ok := t.session.deferredCommandsMap[key]; ok {
+		return true
+	}
+	documentInfo := t.session.documentsByID.getValue(t.docID)
+	return documentInfo != nil && t.session.deletedEntities.contains(documentInfo.entity)
+}
+
+// returns deferred command for this time series, creating it if needed
+func (t *SessionDocumentTimeSeries) getOrDeferCommand() (*TimeSeriesBatchCommandData, error) {
+	key := newIDTypeAndName(t.docID, CommandTimeSeries, t.name)
+	if cmd, ok := t.session.deferredCommandsMap[key]; ok {
+		return cmd.(*TimeSeriesBatchCommandData), nil
+	}
+	cmd, err := NewTimeSeriesBatchCommandData(t.docID, t.name, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	t.session.Defer(cmd)
+	return cmd, nil
+}
+
+// forget cached ranges because they no longer reflect the server
+func (t *SessionDocumentTimeSeries) invalidateCache() {
+	if cache := t.session.timeSeriesByDocID[strings.ToLower(t.docID)]; cache != nil {
+		delete(cache, strings.ToLower(t.name))
+	}
+}
+
+// Append appends an entry with given values. tag is optional
+func (t *SessionDocumentTimeSeries) Append(timestamp time.Time, values []float64, tag string) error {
+	if len(values) == 0 {
+		return newIllegalArgumentError("values cannot be empty")
+	}
+	if t.isDocumentDeletedInSession() {
+		return newIllegalStateError("Can't append new time series value to document " + t.docID + ", the document was already deleted in this session")
+	}
+	cmd, err := t.getOrDeferCommand()
+	if err != nil {
+		return err
+	}
+	cmd.timeSeries.Append(&TimeSeriesAppendOperation{
+		Timestamp: timestamp,
+		Values:    values,
+		Tag:       tag,
+	})
+	t.invalidateCache()
+	return nil
+}
+
+// AppendTyped appends an entry whose values are taken from fields of a struct.
+// See TimeSeriesValuesFromStruct for how fields are mapped to values
+func (t *SessionDocumentTimeSeries) AppendTyped(timestamp time.Time, value interface{}, tag string) error {
+	values, err := TimeSeriesValuesFromStruct(value)
+	if err != nil {
+		return err
+	}
+	return t.Append(timestamp, values, tag)
+}
+
+// Delete deletes entries between from and to (inclusive).
+// nil from or to means the range is unbounded
+func (t *SessionDocumentTimeSeries) Delete(from *time.Time, to *time.Time) error {
+	if t.isDocumentDeletedInSession() {
+		// time series are deleted together with the document
+		return nil
+	}
+	cmd, err := t.getOrDeferCommand()
+	if err != nil {
+		return err
+	}
+	cmd.timeSeries.Delete(&TimeSeriesDeleteOperation{
+		From: from,
+		To:   to,
+	})
+	t.invalidateCache()
+	return nil
+}
+
+// DeleteAt deletes an entry at a given timestamp
+func (t *SessionDocumentTimeSeries) DeleteAt(timestamp time.Time) error {
+	return t.Delete(&timestamp, &timestamp)
+}
+
+// Get returns entries between from and to (inclusive), skipping start entries
+// and returning at most pageSize entries. nil from or to means the range
+// is unbounded, pageSize of 0 means no limit.
+// Returns nil if the document or the time series doesn't exist
+func (t *SessionDocumentTimeSeries) Get(from *time.Time, to *time.Time, start int, pageSize int) ([]*TimeSeriesEntry, error) {
+	if pageSize <= 0 {
+		pageSize = math.MaxInt32
+	}
+	fromTime := timeSeriesFromOrMin(from)
+	toTime := timeSeriesToOrMax(to)
+
+	if entries, ok := t.getFromCache(fromTime, toTime); ok {
+		return pageTimeSeriesEntries(entries, start, pageSize), nil
+	}
+
+	if err := t.session.incrementRequestCount(); err != nil {
+		return nil, err
+	}
+	cmd := newGetTimeSeriesCommand(t.docID, t.name, from, to, start, pageSize)
+	if err := t.session.requestExecutor.ExecuteCommand(cmd, t.session.sessionInfo); err != nil {
+		return nil, err
+	}
+	result := cmd.Result
+	if result == nil {
+		return nil, nil
+	}
+
+	// only a complete range can be used to answer future requests
+	if start == 0 && pageSize == math.MaxInt32 && !t.session.noTracking {
+		result.From = fromTime
+		result.To = toTime
+		t.session.addTimeSeriesRange(t.docID, t.name, result)
+	}
+	return result.Entries, nil
+}
+
+func (t *SessionDocumentTimeSeries) getFromCache(from time.Time, to time.Time) ([]*TimeSeriesEntry, bool) {
+	cache := t.session.timeSeriesByDocID[strings.ToLower(t.docID)]
+	if cache == nil {
+		return nil, false
+	}
+	for _, r := range cache[strings.ToLower(t.name)] {
+		if !r.covers(from, to) {
+			continue
+		}
+		var res []*TimeSeriesEntry
+		for _, e := range r.Entries {
+			if e.Timestamp.Before(from) || e.Timestamp.After(to) {
+				continue
+			}
+			res = append(res, e)
+		}
+		return res, true
+	}
+	return nil, false
+}
+
+func pageTimeSeriesEntries(entries []*TimeSeriesEntry, start int, pageSize int) []*TimeSeriesEntry {
+	if start >= len(entries) {
+		return nil
+	}
+	entries = entries[start:]
+	if pageSize < len(entries) {
+		entries = entries[:pageSize]
+	}
+	return entries
+}
+
+func (s *InMemoryDocumentSessionOperations) addTimeSeriesRange(docID string, name string, r *TimeSeriesRangeResult) {
+	docKey := strings.ToLower(docID)
+	cache := s.timeSeriesByDocID[docKey]
+	if cache == nil {
+		cache = map[string][]*TimeSeriesRangeResult{}
+		s.timeSeriesByDocID[docKey] = cache
+	}
+	nameKey := strings.ToLower(name)
+	cache[nameKey] = append(cache[nameKey], r)
+}
+
+// registerTimeSeries remembers time series ranges included in the result of
+// loading or querying documents
+func (s *InMemoryDocumentSessionOperations) registerTimeSeries(includes map[string]map[string][]*TimeSeriesRangeResult) {
+	if s.noTracking {
+		return
+	}
+	for docID, byName := range includes {
+		for name, ranges := range byName {
+			for _, r := range ranges {
+				if r != nil {
+					s.addTimeSeriesRange(docID, name, r)
+				}
+			}
+		}
+	}
+}
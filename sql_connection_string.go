@@ -0,0 +1,20 @@
+package ravendb
+
+// SqlConnectionString represents connection string for a relational database
+// used by SQL ETL
+type SqlConnectionString struct {
+	ConnectionString
+	// ConnectionStringValue is the connection string of the database. In Go
+	// the name ConnectionString is taken by the embedded struct
+	ConnectionStringValue string `json:"ConnectionString"`
+	// FactoryName is the name of .NET data provider e.g. "System.Data.SqlClient"
+	// or "Npgsql"
+	FactoryName string `json:"FactoryName"`
+}
+
+// NewSqlConnectionString returns new SqlConnectionString
+func NewSqlConnectionString() *SqlConnectionString {
+	res := &SqlConnectionString{}
+	res.Type = ConnectionStringTypeSQL
+	return res
+}
@@ -0,0 +1,31 @@
+package ravendb
+
+// SqlEtlTable describes a table that SQL ETL writes to. Rows are
+// deleted and re-inserted by DocumentIDColumn when a document changes
+type SqlEtlTable struct {
+	TableName        string `json:"TableName"`
+	DocumentIDColumn string `json:"DocumentIdColumn"`
+	InsertOnlyMode   bool   `json:"InsertOnlyMode"`
+}
+
+// SqlEtlConfiguration describes an ETL task that writes documents to
+// a relational database. ConnectionStringName is a name of
+// SqlConnectionString
+type SqlEtlConfiguration struct {
+	EtlConfiguration
+	ParameterizeDeletes bool           `json:"ParameterizeDeletes"`
+	ForceQueryRecompile bool           `json:"ForceQueryRecompile"`
+	QuoteTables         bool           `json:"QuoteTables"`
+	CommandTimeout      *int64         `json:"CommandTimeout"`
+	SqlTables           []*SqlEtlTable `json:"SqlTables"`
+}
+
+// NewSqlEtlConfiguration returns new SqlEtlConfiguration
+func NewSqlEtlConfiguration() *SqlEtlConfiguration {
+	res := &SqlEtlConfiguration{
+		ParameterizeDeletes: true,
+		QuoteTables:         true,
+	}
+	res.EtlType = EtlTypeSQL
+	return res
+}
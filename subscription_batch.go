@@ -1,7 +1,6 @@
 package ravendb
 
 import (
-	"log"
 	"reflect"
 )
 
@@ -52,7 +51,7 @@ type SubscriptionBatch struct {
 	store           *DocumentStore
 	dbName          string
 
-	logger                      *log.Logger
+	logger                      Logger
 	generateEntityIdOnTheClient *generateEntityIDOnTheClient
 
 	Items []*SubscriptionBatchItem
@@ -66,7 +65,7 @@ func (b *SubscriptionBatch) OpenSession() (*DocumentSession, error) {
 	return b.store.OpenSessionWithOptions(sessionOptions)
 }
 
-func newSubscriptionBatch(clazz reflect.Type, revisions bool, requestExecutor *RequestExecutor, store *DocumentStore, dbName string, logger *log.Logger) *SubscriptionBatch {
+func newSubscriptionBatch(clazz reflect.Type, revisions bool, requestExecutor *RequestExecutor, store *DocumentStore, dbName string, logger Logger) *SubscriptionBatch {
 	res := &SubscriptionBatch{
 		clazz:           clazz,
 		revisions:       revisions,
@@ -106,9 +105,7 @@ func (b *SubscriptionBatch) initialize(batch []*subscriptionConnectionServerMess
 			return "", throwRequired("@change-vector field")
 		}
 		lastReceivedChangeVector = changeVector
-		if b.logger != nil {
-			b.logger.Printf("Got %s (change vector: [%s], size: %d)", id, lastReceivedChangeVector, len(curDoc))
-		}
+		b.logger.Debug("subscription: got document", "id", id, "changeVector", lastReceivedChangeVector, "size", len(curDoc))
 		var instance interface{}
 
 		if item.Exception == "" {
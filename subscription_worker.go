@@ -3,7 +3,6 @@ package ravendb
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"net"
 	"reflect"
 	"strconv"
@@ -24,7 +23,6 @@ var (
 type SubscriptionWorker struct {
 	clazz     reflect.Type
 	revisions bool
-	logger    *log.Logger
 	store     *DocumentStore
 	dbName    string
 
@@ -50,6 +48,10 @@ type SubscriptionWorker struct {
 	mu  sync.Mutex
 }
 
+func (w *SubscriptionWorker) logger() Logger {
+	return w.store.GetConventions().getLogger()
+}
+
 // Err returns a potential error, available after worker finished
 func (w *SubscriptionWorker) Err() error {
 	if v := w.err.Load(); v == nil {
@@ -281,6 +283,7 @@ func (w *SubscriptionWorker) connectToServer() (net.Conn, error) {
 	parameters.readResponseAndGetVersionCallback = fn
 	parameters.destinationNodeTag = w.getCurrentNodeTag()
 	parameters.destinationUrl = command.Result.URL
+	parameters.logger = w.logger()
 
 	w.supportedFeatures, err = negotiateProtocolVersion(tcpClient, parameters)
 	if err != nil {
@@ -443,7 +446,7 @@ func (w *SubscriptionWorker) processSubscriptionInner(cb func(batch *Subscriptio
 		return nil
 	}
 
-	batch := newSubscriptionBatch(w.clazz, w.revisions, w.subscriptionLocalRequestExecutor, w.store, w.dbName, w.logger)
+	batch := newSubscriptionBatch(w.clazz, w.revisions, w.subscriptionLocalRequestExecutor, w.store, w.dbName, w.logger())
 
 	for !w.isCancellationRequested() {
 		incomingBatch, err := w.readSingleSubscriptionBatchFromServer(batch)
@@ -457,6 +460,7 @@ func (w *SubscriptionWorker) processSubscriptionInner(cb func(batch *Subscriptio
 		if err != nil {
 			return err
 		}
+		w.store.GetConventions().metrics.subscriptionBatchReceived(len(batch.Items))
 
 		// Send a copy so that the client can safely access it
 		// only copy the fields needed in OpenSession
@@ -592,9 +596,7 @@ func (w *SubscriptionWorker) runSubscriptionAsync(cb func(*SubscriptionBatch) er
 	for !w.isCancellationRequested() {
 		w.closeTcpClient()
 
-		if w.logger != nil {
-			w.logger.Print("Subscription " + w.options.SubscriptionName + ". Connecting to server...")
-		}
+		w.logger().Info("subscription: connecting to server", "subscription", w.options.SubscriptionName)
 
 		//fmt.Printf("before w.processSubscription\n")
 		ex := w.processSubscription(cb)
@@ -613,10 +615,12 @@ func (w *SubscriptionWorker) runSubscriptionAsync(cb func(*SubscriptionBatch) er
 		//fmt.Printf("shouldTryReconnect() returned err='%s'\n", err)
 		if err != nil || !shouldReconnect {
 			if err != nil {
+				w.logger().Error("subscription: failed", "subscription", w.options.SubscriptionName, "error", err)
 				w.err.Store(err)
 			}
 			return
 		}
+		w.logger().Warn("subscription: connection failed, retrying", "subscription", w.options.SubscriptionName, "error", ex, "wait", time.Duration(w.options.TimeToWaitBeforeConnectionRetry))
 		time.Sleep(time.Duration(w.options.TimeToWaitBeforeConnectionRetry))
 		for _, cb := range w.onSubscriptionConnectionRetry {
 			cb(ex)
@@ -9,4 +9,13 @@ type tcpNegotiateParameters struct {
 	destinationUrl     string
 
 	readResponseAndGetVersionCallback func(string) int
+
+	logger Logger
+}
+
+func (p *tcpNegotiateParameters) destination() string {
+	if p.destinationNodeTag != "" {
+		return p.destinationNodeTag
+	}
+	return p.destinationUrl
 }
@@ -12,17 +12,17 @@ const (
 )
 
 func negotiateProtocolVersion(stream io.Writer, parameters *tcpNegotiateParameters) (*supportedFeatures, error) {
+	logger := parameters.logger
+	if logger == nil {
+		logger = nopLogger{}
+	}
 	v := parameters.version
 	currentRef := &v
 	for {
+		logger.Debug("tcp: sending negotiation", "operation", parameters.operation, "version", *currentRef)
 		sendTcpVersionInfo(stream, parameters, *currentRef)
 		version := parameters.readResponseAndGetVersionCallback(parameters.destinationUrl)
-
-		/*
-			   if (logger.isInfoEnabled()) {
-				   logger.info("Read response from " + ObjectUtils.firstNonNull(parameters.getSourceNodeTag(), parameters.getDestinationUrl()) + " for " + parameters.getOperation() + ", received version is '" + version + "'");
-			   }
-		*/
+		logger.Debug("tcp: read negotiation response", "destination", parameters.destination(), "operation", parameters.operation, "version", version)
 
 		if version == *currentRef {
 			break
@@ -40,28 +40,13 @@ func negotiateProtocolVersion(stream io.Writer, parameters *tcpNegotiateParamete
 			return nil, newIllegalArgumentError("The " + parameters.operation + " version " + strconv.Itoa(parameters.version) + " is out of range, out lowest version is " + strconv.Itoa(*currentRef))
 		}
 
-		/*
-		   if (logger.isInfoEnabled()) {
-		       logger.info("The version " +  version + " is " + status + ", will try to agree on '"
-		               + currentRef.value + "' for " + parameters.getOperation() + " with "
-		               + ObjectUtils.firstNonNull(parameters.getDestinationNodeTag(), parameters.getDestinationUrl()));
-		   }
-		*/
+		logger.Info("tcp: version not supported, will try to agree on another", "destination", parameters.destination(), "operation", parameters.operation, "version", version, "nextVersion", *currentRef)
 	}
-	/*
-		   if (logger.isInfoEnabled()) {
-			   logger.info(ObjectUtils.firstNonNull(parameters.getDestinationNodeTag(), parameters.getDestinationUrl()) + " agreed on version " + currentRef.value + " for " + parameters.getOperation());
-		   }
-	*/
+	logger.Info("tcp: agreed on version", "destination", parameters.destination(), "operation", parameters.operation, "version", *currentRef)
 	return getSupportedFeaturesFor(parameters.operation, *currentRef), nil
 }
 
 func sendTcpVersionInfo(stream io.Writer, parameters *tcpNegotiateParameters, currentVersion int) error {
-	/*
-		if (logger.isInfoEnabled()) {
-			logger.info("Send negotiation for " + parameters.getOperation() + " in version " + currentVersion);
-		}
-	*/
 	m := map[string]interface{}{
 		"DatabaseName":     parameters.database,
 		"Operation":        parameters.operation,
@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+const analyzersMyAnalyzerCode = `using System.IO;
+using Lucene.Net.Analysis;
+using Lucene.Net.Analysis.Standard;
+
+namespace SlowTests.Data.RavenDB_14939
+{
+    public class MyAnalyzer : StandardAnalyzer
+    {
+        public MyAnalyzer()
+            : base(Lucene.Net.Util.Version.LUCENE_30)
+        {
+        }
+
+        public override TokenStream TokenStream(string fieldName, TextReader reader)
+        {
+            return new ASCIIFoldingFilter(base.TokenStream(fieldName, reader));
+        }
+    }
+}`
+
+func NewCompaniesByNameWithMyAnalyzer() *ravendb.IndexCreationTask {
+	res := ravendb.NewIndexCreationTask("Companies/ByNameWithMyAnalyzer")
+	res.Map = "from c in docs.Companies select new { c.Name }"
+	res.Index("Name", ravendb.FieldIndexingSearch)
+	res.Analyze("Name", "MyAnalyzer")
+	return res
+}
+
+func analyzersCanUseCustomAnalyzer(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	analyzer := &ravendb.AnalyzerDefinition{
+		Name: "MyAnalyzer",
+		Code: analyzersMyAnalyzerCode,
+	}
+	putOp, err := ravendb.NewPutAnalyzersOperation(analyzer)
+	assert.NoError(t, err)
+	err = store.Maintenance().Send(putOp)
+	assert.NoError(t, err)
+
+	index := NewCompaniesByNameWithMyAnalyzer()
+	err = index.Execute(store, nil, "")
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store)
+		err = session.Store(&Company{Name: "Rámen"})
+		assert.NoError(t, err)
+		err = session.Store(&Company{Name: "Sushi"})
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	err = driver.waitForIndexing(store, "", 0)
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store)
+		companies, err := ravendb.QueryIndex[Company](session, index.IndexName).Search("Name", "ramen").ToList()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(companies))
+		session.Close()
+	}
+
+	deleteOp, err := ravendb.NewDeleteAnalyzerOperation("MyAnalyzer")
+	assert.NoError(t, err)
+	err = store.Maintenance().Send(deleteOp)
+	assert.NoError(t, err)
+}
+
+func analyzersCanPutAndDeleteServerWideAnalyzer(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	analyzer := &ravendb.AnalyzerDefinition{
+		Name: "MyAnalyzer",
+		Code: analyzersMyAnalyzerCode,
+	}
+	putOp, err := ravendb.NewPutServerWideAnalyzersOperation(analyzer)
+	assert.NoError(t, err)
+	err = store.Maintenance().Server().Send(putOp)
+	assert.NoError(t, err)
+
+	// the analyzer is available without uploading it to the database
+	index := NewCompaniesByNameWithMyAnalyzer()
+	err = index.Execute(store, nil, "")
+	assert.NoError(t, err)
+
+	deleteIndexOp := ravendb.NewDeleteIndexOperation(index.IndexName)
+	err = store.Maintenance().Send(deleteIndexOp)
+	assert.NoError(t, err)
+
+	deleteOp, err := ravendb.NewDeleteServerWideAnalyzerOperation("MyAnalyzer")
+	assert.NoError(t, err)
+	err = store.Maintenance().Server().Send(deleteOp)
+	assert.NoError(t, err)
+}
+
+func TestAnalyzers(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	analyzersCanUseCustomAnalyzer(t, driver)
+	analyzersCanPutAndDeleteServerWideAnalyzer(t, driver)
+}
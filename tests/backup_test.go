@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func backupStoreUser(t *testing.T, store *ravendb.DocumentStore, id string, name string) {
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	user := &User{}
+	user.setName(name)
+	err := session.StoreWithID(user, id)
+	assert.NoError(t, err)
+	err = session.SaveChanges()
+	assert.NoError(t, err)
+}
+
+func backupCanBackupAndRestore(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	backupStoreUser(t, store, "users/1", "John")
+
+	configuration := &ravendb.PeriodicBackupConfiguration{
+		BackupConfiguration:        *ravendb.NewBackupConfiguration(ravendb.BackupTypeBackup, t.TempDir()),
+		Name:                       "backup",
+		IncrementalBackupFrequency: "0 0 1 1 *",
+	}
+	updateOp := ravendb.NewUpdatePeriodicBackupOperation(configuration)
+	err := store.Maintenance().Send(updateOp)
+	assert.NoError(t, err)
+	taskID := updateOp.Command.Result.TaskID
+	assert.True(t, taskID > 0)
+
+	operation, err := store.Maintenance().SendAsync(ravendb.NewStartBackupOperation(true, taskID))
+	assert.NoError(t, err)
+	err = operation.WaitForCompletion()
+	assert.NoError(t, err)
+
+	statusOp := ravendb.NewGetPeriodicBackupStatusOperation(taskID)
+	err = store.Maintenance().Send(statusOp)
+	assert.NoError(t, err)
+	status := statusOp.Command.Result.Status
+	assert.NotNil(t, status)
+	assert.Equal(t, taskID, status.TaskID)
+	assert.True(t, status.IsFull)
+	assert.NotNil(t, status.LastFullBackup)
+	assert.Nil(t, status.Error)
+	backupDirectory := status.LocalBackup.BackupDirectory
+	assert.NotEmpty(t, backupDirectory)
+
+	restoredName := store.GetDatabase() + "_restored"
+	restoreOp := ravendb.NewRestoreBackupOperation(&ravendb.RestoreBackupConfiguration{
+		DatabaseName:   restoredName,
+		BackupLocation: backupDirectory,
+	})
+	operation, err = store.Maintenance().Server().SendAsync(restoreOp)
+	assert.NoError(t, err)
+	err = operation.WaitForCompletion()
+	assert.NoError(t, err)
+	defer func() {
+		err := store.Maintenance().Server().Send(ravendb.NewDeleteDatabasesOperation(restoredName, true))
+		assert.NoError(t, err)
+	}()
+
+	session, err := store.OpenSessionWithOptions(&ravendb.SessionOptions{
+		Database: restoredName,
+	})
+	assert.NoError(t, err)
+	defer session.Close()
+	var user *User
+	err = session.Load(&user, "users/1")
+	assert.NoError(t, err)
+	assert.NotNil(t, user)
+	assert.Equal(t, "John", *user.Name)
+}
+
+func backupCanRunOneTimeBackup(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	backupStoreUser(t, store, "users/1", "John")
+
+	configuration := ravendb.NewBackupConfiguration(ravendb.BackupTypeSnapshot, t.TempDir())
+	operation, err := store.Maintenance().SendAsync(ravendb.NewBackupOperation(configuration))
+	assert.NoError(t, err)
+	err = operation.WaitForCompletion()
+	assert.NoError(t, err)
+}
+
+func TestBackup(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	backupCanBackupAndRestore(t, driver)
+	backupCanRunOneTimeBackup(t, driver)
+}
@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -147,6 +148,55 @@ func bulkInsertsTestCanModifyMetadataWithBulkInsert(t *testing.T, driver *RavenT
 	}
 }
 
+func bulkInsertsTestCanUseCompression(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	compressedStore := ravendb.NewDocumentStore(store.GetUrls(), store.GetDatabase())
+	compressedStore.GetConventions().Compression = ravendb.CompressionAlgorithmGzip
+	err = compressedStore.Initialize()
+	assert.NoError(t, err)
+	defer compressedStore.Close()
+
+	{
+		bulkInsert := compressedStore.BulkInsert("")
+		for i := 0; i < 100; i++ {
+			fooBar := &FooBar{
+				Name: "John Doe " + strconv.Itoa(i),
+			}
+			_, err = bulkInsert.Store(fooBar, nil)
+			assert.NoError(t, err)
+		}
+		err = bulkInsert.Close()
+		assert.NoError(t, err)
+	}
+
+	{
+		session := openSessionMust(t, compressedStore)
+		fooBar := &FooBar{
+			Name: "Jane Doe",
+		}
+		err = session.StoreWithID(fooBar, "foobars/jane")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		var doc1, doc2 *FooBar
+		err = session.Load(&doc1, "FooBars/100-A")
+		assert.NoError(t, err)
+		assert.Equal(t, "John Doe 99", doc1.Name)
+		err = session.Load(&doc2, "foobars/jane")
+		assert.NoError(t, err)
+		assert.Equal(t, "Jane Doe", doc2.Name)
+		session.Close()
+	}
+}
+
 type FooBar struct {
 	Name string
 }
@@ -161,4 +211,5 @@ func TestBulkInserts(t *testing.T) {
 	bulkInsertsTestShouldNotAcceptIdsEndingWithPipeLine(t, driver)
 	bulkInsertsTestKilledToEarly(t, driver)
 	bulkInsertsTestCanModifyMetadataWithBulkInsert(t, driver)
+	bulkInsertsTestCanUseCompression(t, driver)
 }
@@ -0,0 +1,280 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func countersIncrementAndGet(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("Aviv")
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+
+		counters, err := session.CountersFor(user)
+		assert.NoError(t, err)
+		err = counters.Increment("likes", 10)
+		assert.NoError(t, err)
+		err = counters.Increment("likes", 5)
+		assert.NoError(t, err)
+		err = counters.Increment("dislikes", 1)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+
+		// values returned by the batch are cached in the session
+		n := session.Advanced().GetNumberOfRequests()
+		v, err := counters.Get("likes")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(15), *v)
+		assert.Equal(t, n, session.Advanced().GetNumberOfRequests())
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		counters, err := session.CountersForDocumentID("users/1")
+		assert.NoError(t, err)
+		all, err := counters.GetAll()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(all))
+		assert.Equal(t, int64(15), all["likes"])
+		assert.Equal(t, int64(1), all["dislikes"])
+
+		v, err := counters.Get("does-not-exist")
+		assert.NoError(t, err)
+		assert.Nil(t, v)
+		session.Close()
+	}
+
+	{
+		op := ravendb.NewGetCountersOperation("users/1", []string{"likes"}, false)
+		err = store.Operations().Send(op, nil)
+		assert.NoError(t, err)
+		details := op.Command.Result
+		assert.Equal(t, 1, len(details.Counters))
+		assert.Equal(t, "likes", details.Counters[0].CounterName)
+		assert.Equal(t, int64(15), details.Counters[0].TotalValue)
+	}
+}
+
+func countersDelete(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		counters, err := session.CountersForDocumentID("users/1")
+		assert.NoError(t, err)
+		err = counters.Increment("likes", 1)
+		assert.NoError(t, err)
+		err = counters.Increment("views", 1)
+		assert.NoError(t, err)
+
+		// can't delete a counter that is being incremented in the same session
+		err = counters.Delete("likes")
+		assert.Error(t, err)
+		_, ok := err.(*ravendb.IllegalStateError)
+		assert.True(t, ok)
+
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		counters, err := session.CountersForDocumentID("users/1")
+		assert.NoError(t, err)
+		err = counters.Delete("likes")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		counters, err := session.CountersForDocumentID("users/1")
+		assert.NoError(t, err)
+		all, err := counters.GetAll()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(all))
+		assert.Equal(t, int64(1), all["views"])
+		session.Close()
+	}
+}
+
+func countersBatchOperation(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		err = session.StoreWithID(&User{}, "users/2")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	batch := &ravendb.CounterBatch{
+		Documents: []*ravendb.DocumentCountersOperation{
+			{
+				DocumentID: "users/1",
+				Operations: []*ravendb.CounterOperation{
+					{Type: ravendb.CounterOperationTypeIncrement, CounterName: "likes", Delta: 3},
+				},
+			},
+			{
+				DocumentID: "users/2",
+				Operations: []*ravendb.CounterOperation{
+					{Type: ravendb.CounterOperationTypeIncrement, CounterName: "likes", Delta: 7},
+				},
+			},
+		},
+	}
+	op := ravendb.NewCounterBatchOperation(batch)
+	err = store.Operations().Send(op, nil)
+	assert.NoError(t, err)
+	details := op.Command.Result
+	assert.Equal(t, 2, len(details.Counters))
+	assert.Equal(t, int64(3), details.Counters[0].TotalValue)
+	assert.Equal(t, int64(7), details.Counters[1].TotalValue)
+}
+
+func countersInclude(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("Grisha")
+		err = session.StoreWithID(user, "users/1")
+		assert.NoError(t, err)
+		counters, err := session.CountersFor(user)
+		assert.NoError(t, err)
+		err = counters.Increment("likes", 100)
+		assert.NoError(t, err)
+		err = counters.Increment("views", 2)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		var user *User
+		err = session.IncludeCounters("likes", "missing").Load(&user, "users/1")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, session.Advanced().GetNumberOfRequests())
+
+		counters, err := session.CountersFor(user)
+		assert.NoError(t, err)
+		v, err := counters.Get("likes")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), *v)
+		v, err = counters.Get("missing")
+		assert.NoError(t, err)
+		assert.Nil(t, v)
+		assert.Equal(t, 1, session.Advanced().GetNumberOfRequests())
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		var users []*User
+		q := session.QueryCollection("users").IncludeAllCounters()
+		err = q.GetResults(&users)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(users))
+		assert.Equal(t, 1, session.Advanced().GetNumberOfRequests())
+
+		counters, err := session.CountersForDocumentID("users/1")
+		assert.NoError(t, err)
+		all, err := counters.GetAll()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(all))
+		assert.Equal(t, int64(2), all["views"])
+		assert.Equal(t, 1, session.Advanced().GetNumberOfRequests())
+		session.Close()
+	}
+}
+
+func countersChanges(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	changes := store.Changes("")
+	err = changes.EnsureConnectedNow()
+	assert.NoError(t, err)
+
+	chChanges := make(chan *ravendb.CounterChange, 8)
+	cb := func(change *ravendb.CounterChange) {
+		chChanges <- change
+	}
+	cancel, err := changes.ForCounterOfDocument("users/1", "likes", cb)
+	assert.NoError(t, err)
+	defer cancel()
+
+	{
+		session := openSessionMust(t, store)
+		counters, err := session.CountersForDocumentID("users/1")
+		assert.NoError(t, err)
+		err = counters.Increment("likes", 1)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	select {
+	case change := <-chChanges:
+		assert.Equal(t, "users/1", change.DocumentID)
+		assert.Equal(t, "likes", change.Name)
+		assert.Equal(t, int64(1), change.Value)
+		assert.Equal(t, ravendb.CounterChangePut, change.Type)
+	case <-time.After(_reasonableWaitTime):
+		assert.Fail(t, "timed out waiting for counter change")
+	}
+}
+
+func TestCounters(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	countersIncrementAndGet(t, driver)
+	countersDelete(t, driver)
+	countersBatchOperation(t, driver)
+	countersInclude(t, driver)
+	countersChanges(t, driver)
+}
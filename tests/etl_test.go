@@ -0,0 +1,170 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func etlWaitForDocument(t *testing.T, store *ravendb.DocumentStore, id string, timeout time.Duration) *User {
+	sw := time.Now()
+	for time.Since(sw) < timeout {
+		session := openSessionMust(t, store)
+		var user *User
+		err := session.Load(&user, id)
+		session.Close()
+		assert.NoError(t, err)
+		if user != nil {
+			return user
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+	return nil
+}
+
+func etlAddRavenEtl(t *testing.T, src *ravendb.DocumentStore, dst *ravendb.DocumentStore) *ravendb.RavenEtlConfiguration {
+	connectionString := ravendb.NewRavenConnectionString()
+	connectionString.Name = dst.GetDatabase()
+	connectionString.Database = dst.GetDatabase()
+	connectionString.TopologyDiscoveryUrls = dst.GetUrls()
+	err := src.Maintenance().Send(ravendb.NewPutConnectionStringOperation(connectionString))
+	assert.NoError(t, err)
+
+	configuration := ravendb.NewRavenEtlConfiguration()
+	configuration.Name = "etl-" + dst.GetDatabase()
+	configuration.ConnectionStringName = connectionString.Name
+	configuration.Transforms = []*ravendb.Transformation{
+		{
+			Name:        "users",
+			Collections: []string{"Users"},
+		},
+	}
+	op, err := ravendb.NewAddEtlOperation(configuration)
+	assert.NoError(t, err)
+	err = src.Maintenance().Send(op)
+	assert.NoError(t, err)
+	assert.True(t, op.Command.Result.TaskID > 0)
+	configuration.TaskID = op.Command.Result.TaskID
+	return configuration
+}
+
+func etlStoreUser(t *testing.T, store *ravendb.DocumentStore, id string, name string) {
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	user := &User{}
+	user.setName(name)
+	err := session.StoreWithID(user, id)
+	assert.NoError(t, err)
+	err = session.SaveChanges()
+	assert.NoError(t, err)
+}
+
+func etlCanAddEtl(t *testing.T, driver *RavenTestDriver) {
+	src := driver.getDocumentStoreMust(t)
+	defer src.Close()
+	dst := driver.getDocumentStoreMust(t)
+	defer dst.Close()
+
+	etlStoreUser(t, src, "users/1", "Marcin")
+	etlAddRavenEtl(t, src, dst)
+
+	user := etlWaitForDocument(t, dst, "users/1", time.Second*10)
+	assert.NotNil(t, user)
+}
+
+func etlCanUpdateEtl(t *testing.T, driver *RavenTestDriver) {
+	src := driver.getDocumentStoreMust(t)
+	defer src.Close()
+	dst := driver.getDocumentStoreMust(t)
+	defer dst.Close()
+
+	etlStoreUser(t, src, "users/1", "Marcin")
+	configuration := etlAddRavenEtl(t, src, dst)
+	user := etlWaitForDocument(t, dst, "users/1", time.Second*10)
+	assert.NotNil(t, user)
+
+	configuration.Transforms[0].Script = "loadToUsers({ Name: this.Name + ' 2' })"
+	op, err := ravendb.NewUpdateEtlOperation(configuration.TaskID, configuration)
+	assert.NoError(t, err)
+	err = src.Maintenance().Send(op)
+	assert.NoError(t, err)
+
+	etlStoreUser(t, src, "users/2", "Marcin")
+	user = etlWaitForDocument(t, dst, "users/2", time.Second*10)
+	if assert.NotNil(t, user) {
+		assert.Equal(t, "Marcin 2", *user.Name)
+	}
+}
+
+func etlCanGetTaskInfoAndDisableEtl(t *testing.T, driver *RavenTestDriver) {
+	src := driver.getDocumentStoreMust(t)
+	defer src.Close()
+	dst := driver.getDocumentStoreMust(t)
+	defer dst.Close()
+
+	configuration := etlAddRavenEtl(t, src, dst)
+
+	infoOp := ravendb.NewGetOngoingTaskInfoOperation(configuration.TaskID, ravendb.OngoingTaskTypeRavenEtl)
+	err := src.Maintenance().Send(infoOp)
+	assert.NoError(t, err)
+	info := infoOp.Command.Result.(*ravendb.OngoingTaskRavenEtlDetails)
+	assert.Equal(t, configuration.Name, info.TaskName)
+	assert.Equal(t, ravendb.OngoingTaskStateEnabled, info.TaskState)
+	assert.Equal(t, dst.GetDatabase(), info.DestinationDatabase)
+
+	toggleOp := ravendb.NewToggleOngoingTaskStateOperation(configuration.TaskID, ravendb.OngoingTaskTypeRavenEtl, true)
+	err = src.Maintenance().Send(toggleOp)
+	assert.NoError(t, err)
+
+	infoOp = ravendb.NewGetOngoingTaskInfoOperationWithName(configuration.Name, ravendb.OngoingTaskTypeRavenEtl)
+	err = src.Maintenance().Send(infoOp)
+	assert.NoError(t, err)
+	info = infoOp.Command.Result.(*ravendb.OngoingTaskRavenEtlDetails)
+	assert.Equal(t, ravendb.OngoingTaskStateDisabled, info.TaskState)
+
+	etlStoreUser(t, src, "users/1", "Marcin")
+	user := etlWaitForDocument(t, dst, "users/1", time.Second*2)
+	assert.Nil(t, user)
+}
+
+func etlCanResetEtl(t *testing.T, driver *RavenTestDriver) {
+	src := driver.getDocumentStoreMust(t)
+	defer src.Close()
+	dst := driver.getDocumentStoreMust(t)
+	defer dst.Close()
+
+	etlStoreUser(t, src, "users/1", "Marcin")
+	configuration := etlAddRavenEtl(t, src, dst)
+	user := etlWaitForDocument(t, dst, "users/1", time.Second*10)
+	assert.NotNil(t, user)
+
+	{
+		session := openSessionMust(t, dst)
+		err := session.DeleteByID("users/1", "")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	err := src.Maintenance().Send(ravendb.NewResetEtlOperation(configuration.Name, "users"))
+	assert.NoError(t, err)
+
+	// after reset the document is sent again
+	user = etlWaitForDocument(t, dst, "users/1", time.Second*10)
+	assert.NotNil(t, user)
+}
+
+func TestEtl(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	etlCanAddEtl(t, driver)
+	etlCanUpdateEtl(t, driver)
+	etlCanGetTaskInfoAndDisableEtl(t, driver)
+	etlCanResetEtl(t, driver)
+}
@@ -0,0 +1,178 @@
+package tests
+
+import (
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func genericSessionStoreUsers(t *testing.T, store *ravendb.DocumentStore) {
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	for i, name := range []string{"John", "Tarzan", "Jane"} {
+		user := &User{Age: 20 + i}
+		user.setName(name)
+		err := session.StoreWithID(user, "users/"+name)
+		assert.NoError(t, err)
+	}
+	err := session.SaveChanges()
+	assert.NoError(t, err)
+}
+
+func genericSessionCanLoad(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	genericSessionStoreUsers(t, store)
+
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	user, err := ravendb.Load[User](session, "users/John")
+	assert.NoError(t, err)
+	assert.NotNil(t, user)
+	assert.Equal(t, "John", *user.Name)
+	assert.Equal(t, "users/John", user.ID)
+
+	user, err = ravendb.Load[User](session, "users/DoesNotExist")
+	assert.NoError(t, err)
+	assert.Nil(t, user)
+
+	users, err := ravendb.LoadMulti[User](session, []string{"users/Jane", "users/Tarzan", "users/DoesNotExist"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(users))
+	assert.Equal(t, "Jane", *users["users/Jane"].Name)
+	assert.Equal(t, "Tarzan", *users["users/Tarzan"].Name)
+	assert.Nil(t, users["users/DoesNotExist"])
+}
+
+func genericSessionCanQuery(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	genericSessionStoreUsers(t, store)
+
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	users, err := ravendb.Query[User](session).
+		WaitForNonStaleResults(0).
+		WhereGreaterThan("age", 20).
+		OrderBy("name").
+		ToList()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(users))
+	assert.Equal(t, "Jane", *users[0].Name)
+	assert.Equal(t, "Tarzan", *users[1].Name)
+
+	user, err := ravendb.Query[User](session).WhereEquals("name", "John").Single()
+	assert.NoError(t, err)
+	assert.Equal(t, "users/John", user.ID)
+
+	user, err = ravendb.Query[User](session).WhereEquals("name", "Nobody").First()
+	assert.NoError(t, err)
+	assert.Nil(t, user)
+
+	n, err := ravendb.Query[User](session).WhereLessThan("age", 22).Count()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
+func genericSessionCanStream(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	genericSessionStoreUsers(t, store)
+
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	{
+		query := ravendb.Query[User](session).WaitForNonStaleResults(0).OrderBy("name")
+		iterator, err := ravendb.StreamQuery(session, query, nil)
+		assert.NoError(t, err)
+		var names []string
+		for {
+			user, err := iterator.Next()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err)
+			names = append(names, *user.Name)
+			assert.NotNil(t, iterator.Metadata())
+		}
+		iterator.Close()
+		assert.Equal(t, []string{"Jane", "John", "Tarzan"}, names)
+	}
+
+	{
+		args := &ravendb.StartsWithArgs{
+			StartsWith: "users/J",
+		}
+		iterator, err := ravendb.Stream[User](session, args)
+		assert.NoError(t, err)
+		n := 0
+		for {
+			_, err := iterator.Next()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err)
+			n++
+		}
+		iterator.Close()
+		assert.Equal(t, 2, n)
+	}
+}
+
+func genericSessionCanUseSubscriptionWorker(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	id, err := store.Subscriptions().CreateForType(reflect.TypeOf(&User{}), nil, "")
+	assert.NoError(t, err)
+
+	genericSessionStoreUsers(t, store)
+
+	opts := ravendb.NewSubscriptionWorkerOptions(id)
+	worker, err := ravendb.GetSubscriptionWorker[User](store, opts, "")
+	assert.NoError(t, err)
+	defer worker.Close()
+
+	names := make(chan string, 16)
+	err = worker.Run(func(batch *ravendb.TypedSubscriptionBatch[User]) error {
+		for _, item := range batch.Items {
+			names <- *item.Result.Name
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	var got []string
+	for len(got) < 3 {
+		select {
+		case name := <-names:
+			got = append(got, name)
+		case <-time.After(_reasonableWaitTime):
+			assert.Fail(t, "timed out waiting for subscription results")
+			return
+		}
+	}
+	assert.ElementsMatch(t, []string{"John", "Tarzan", "Jane"}, got)
+}
+
+func TestGenericSession(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	genericSessionCanLoad(t, driver)
+	genericSessionCanQuery(t, driver)
+	genericSessionCanStream(t, driver)
+	genericSessionCanUseSubscriptionWorker(t, driver)
+}
@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func postsForHighlighting() *ravendb.IndexCreationTask {
+	res := ravendb.NewIndexCreationTask("Posts_ForHighlighting")
+	res.Map = "from p in docs.Posts select new { p.title, p.desc }"
+	for _, field := range []string{"title", "desc"} {
+		res.Index(field, ravendb.FieldIndexingSearch)
+		res.Store(field, ravendb.FieldStorageYes)
+		res.TermVector(field, ravendb.FieldTermVectorWithPositionsAndOffsets)
+	}
+	return res
+}
+
+func highlightsStorePosts(t *testing.T, store *ravendb.DocumentStore) {
+	err := store.ExecuteIndex(postsForHighlighting(), "")
+	assert.NoError(t, err)
+
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	posts := []*Post{
+		{Title: "Go", Desc: "we love programming in go"},
+		{Title: "Java", Desc: "some people prefer programming in java"},
+		{Title: "Cooking", Desc: "recipes for pasta"},
+	}
+	for i, post := range posts {
+		err = session.StoreWithID(post, "posts/"+posts[i].Title)
+		assert.NoError(t, err)
+	}
+	err = session.SaveChanges()
+	assert.NoError(t, err)
+	err = waitForIndexing(store, "", 0)
+	assert.NoError(t, err)
+}
+
+func highlightsCanHighlight(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	highlightsStorePosts(t, store)
+
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	var highlightings *ravendb.Highlightings
+	var results []*Post
+	query := session.QueryIndex("Posts_ForHighlighting").
+		Search("desc", "programming").
+		Highlight("desc", 128, 1, &highlightings)
+	err := query.GetResults(&results)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(results))
+
+	iq, err := query.GetIndexQuery()
+	assert.NoError(t, err)
+	assert.Equal(t, "from index 'Posts_ForHighlighting' where search(desc, $p0) include highlight(desc,128,1)", iq.GetQuery())
+
+	assert.Equal(t, "desc", highlightings.FieldName)
+	assert.ElementsMatch(t, []string{"posts/Go", "posts/Java"}, highlightings.GetResultIndents())
+	fragments := highlightings.GetFragments("posts/Go")
+	assert.Equal(t, 1, len(fragments))
+	assert.Contains(t, fragments[0], ">programming</b>")
+	assert.Nil(t, highlightings.GetFragments("posts/Cooking"))
+}
+
+func highlightsCanUseCustomTags(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	highlightsStorePosts(t, store)
+
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	options := &ravendb.HighlightingOptions{
+		PreTags:  []string{"<em>"},
+		PostTags: []string{"</em>"},
+	}
+	var titleHighlightings, descHighlightings *ravendb.Highlightings
+	var results []*Post
+	query := session.QueryIndex("Posts_ForHighlighting").
+		Search("desc", "programming").
+		OrElse().
+		Search("title", "java").
+		HighlightWithOptions("desc", 128, 1, options, &descHighlightings).
+		HighlightWithOptions("title", 128, 1, options, &titleHighlightings)
+	err := query.GetResults(&results)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(results))
+
+	iq, err := query.GetIndexQuery()
+	assert.NoError(t, err)
+	assert.Equal(t, "from index 'Posts_ForHighlighting' where search(desc, $p0) or search(title, $p1) include highlight(desc,128,1,$p2),highlight(title,128,1,$p3)", iq.GetQuery())
+
+	fragments := descHighlightings.GetFragments("posts/Java")
+	assert.Equal(t, 1, len(fragments))
+	assert.Contains(t, fragments[0], "<em>programming</em>")
+
+	fragments = titleHighlightings.GetFragments("posts/Java")
+	assert.Equal(t, []string{"<em>Java</em>"}, fragments)
+	assert.Nil(t, titleHighlightings.GetFragments("posts/Go"))
+}
+
+func TestHighlights(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	highlightsCanHighlight(t, driver)
+	highlightsCanUseCustomTags(t, driver)
+}
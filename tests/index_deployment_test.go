@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func indexDeploymentDeploysOnlyChangedIndexes(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("John")
+		err = session.Store(user)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	byName := ravendb.NewIndexCreationTask("Users/ByName")
+	byName.Map = "from u in docs.Users select new { u.name }"
+	byNameJS := ravendb.NewJavaScriptIndexCreationTask("Users/ByNameJS")
+	byNameJS.Maps = []string{"map('Users', u => ({ name: u.name }))"}
+	tasks := []ravendb.IAbstractIndexCreationTask{byName, byNameJS}
+
+	result, err := store.DeployIndexes(tasks, "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Users/ByName", "Users/ByNameJS"}, result.IndexNames(ravendb.IndexDeploymentActionCreate))
+
+	err = driver.waitForIndexing(store, "", 0)
+	assert.NoError(t, err)
+
+	result, err = store.DeployIndexes(tasks, "", nil)
+	assert.NoError(t, err)
+	assert.False(t, result.HasChanges(), "%s", result)
+
+	byName.Map = "from u in docs.Users select new { u.name, u.lastName }"
+	options := &ravendb.IndexDeploymentOptions{
+		DeleteUnusedIndexes: true,
+		WaitForReplacement:  true,
+		WaitTimeout:         time.Second * 30,
+	}
+	result, err = store.DeployIndexes([]ravendb.IAbstractIndexCreationTask{byName}, "", options)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Users/ByName"}, result.IndexNames(ravendb.IndexDeploymentActionUpdate))
+	assert.Equal(t, []string{"Users/ByNameJS"}, result.IndexNames(ravendb.IndexDeploymentActionDelete))
+
+	op := ravendb.NewGetIndexNamesOperation(0, 10)
+	err = store.Maintenance().Send(op)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Users/ByName"}, op.Command.Result)
+}
+
+func TestIndexDeployment(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	indexDeploymentDeploysOnlyChangedIndexes(t, driver)
+}
@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+type TaggedPost struct {
+	ID    string
+	Title string `json:"title" ravendb:"index,store"`
+	Body  string `json:"body" ravendb:"search"`
+}
+
+func indexFromStructCanDeployAndQuery(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	index, err := ravendb.NewIndexCreationTaskFromStruct("TaggedPosts/ByTitleAndBody", reflect.TypeOf(&TaggedPost{}), store.GetConventions())
+	assert.NoError(t, err)
+	err = store.ExecuteIndexes([]ravendb.IAbstractIndexCreationTask{index}, "")
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store)
+		posts := []*TaggedPost{
+			{Title: "Go client", Body: "Using RavenDB from Go programs"},
+			{Title: "Java client", Body: "Using RavenDB from Java programs"},
+		}
+		for _, post := range posts {
+			err = session.Store(post)
+			assert.NoError(t, err)
+		}
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	err = driver.waitForIndexing(store, "", 0)
+	assert.NoError(t, err)
+
+	{
+		op := ravendb.NewGetIndexOperation(index.IndexName)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		def := op.Command.Result
+		assert.Equal(t, ravendb.IndexTypeMap, def.GetType())
+		assert.Equal(t, ravendb.FieldStorageYes, def.Fields["title"].Storage)
+		assert.Equal(t, ravendb.FieldIndexing(ravendb.FieldIndexingSearch), def.Fields["body"].Indexing)
+	}
+
+	{
+		session := openSessionMust(t, store)
+		posts, err := ravendb.QueryIndex[TaggedPost](session, index.IndexName).Search("body", "go").ToList()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(posts))
+		assert.Equal(t, "Go client", posts[0].Title)
+		session.Close()
+	}
+}
+
+func TestIndexFromStruct(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	indexFromStructCanDeployAndQuery(t, driver)
+}
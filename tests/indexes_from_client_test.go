@@ -13,7 +13,7 @@ func indexesFromClientTestCanCreateIndexesUsingIndexCreation(t *testing.T, drive
 	store := driver.getDocumentStoreMust(t)
 	defer store.Close()
 
-	indexes := []*ravendb.IndexCreationTask{NewUsers_ByName()}
+	indexes := []ravendb.IAbstractIndexCreationTask{NewUsers_ByName()}
 	err = store.ExecuteIndexes(indexes, "")
 	assert.NoError(t, err)
 
@@ -36,7 +36,7 @@ func indexesFromClientTestCanCreateIndexesUsingIndexCreation(t *testing.T, drive
 		session := openSessionMust(t, store)
 
 		var users []*User
-		q := session.QueryIndex(indexes[0].IndexName)
+		q := session.QueryIndex(indexes[0].GetIndexName())
 		err = q.GetResults(&users)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(users))
@@ -100,7 +100,7 @@ func indexesFromClientTestCanExecuteManyIndexes(t *testing.T, driver *RavenTestD
 	store := driver.getDocumentStoreMust(t)
 	defer store.Close()
 
-	indexes := []*ravendb.IndexCreationTask{NewUsersIndex()}
+	indexes := []ravendb.IAbstractIndexCreationTask{NewUsersIndex()}
 	err = store.ExecuteIndexes(indexes, "")
 	assert.NoError(t, err)
 
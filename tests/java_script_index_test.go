@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"testing"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func NewUsersByNameJavaScriptIndex() *ravendb.JavaScriptIndexCreationTask {
+	res := ravendb.NewJavaScriptIndexCreationTask("UsersByNameJavaScript")
+	res.Maps = []string{"map('Users', u => ({ name: u.name }))"}
+	res.Index("name", ravendb.FieldIndexingSearch)
+	return res
+}
+
+func NewUsersCountByNameJavaScriptIndex() *ravendb.JavaScriptIndexCreationTask {
+	res := ravendb.NewJavaScriptIndexCreationTask("UsersCountByNameJavaScript")
+	res.Maps = []string{"map('Users', u => ({ name: u.name, count: 1 }))"}
+	res.Reduce = "groupBy(x => x.name).aggregate(g => ({ name: g.key, count: g.values.reduce((n, v) => n + v.count, 0) }))"
+	return res
+}
+
+func javaScriptIndexCanUseMap(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	index := NewUsersByNameJavaScriptIndex()
+	err = store.ExecuteIndex(index, "")
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store)
+		for _, name := range []string{"John Doe", "Jane Doe", "Mark Smith"} {
+			user := &User{}
+			user.setName(name)
+			err = session.Store(user)
+			assert.NoError(t, err)
+		}
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	err = driver.waitForIndexing(store, "", 0)
+	assert.NoError(t, err)
+
+	{
+		op := ravendb.NewGetIndexOperation(index.IndexName)
+		err = store.Maintenance().Send(op)
+		assert.NoError(t, err)
+		assert.Equal(t, ravendb.IndexTypeJavaScriptMap, op.Command.Result.GetType())
+	}
+
+	{
+		session := openSessionMust(t, store)
+		users, err := ravendb.QueryIndex[User](session, index.IndexName).Search("name", "doe").ToList()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(users))
+		session.Close()
+	}
+}
+
+func javaScriptIndexCanUseMapReduce(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	index := NewUsersCountByNameJavaScriptIndex()
+	err = store.ExecuteIndex(index, "")
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store)
+		for _, name := range []string{"John", "John", "Jane"} {
+			user := &User{}
+			user.setName(name)
+			err = session.Store(user)
+			assert.NoError(t, err)
+		}
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	err = driver.waitForIndexing(store, "", 0)
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store)
+		result, err := ravendb.QueryIndex[User](session, index.IndexName).WhereEquals("name", "John").Single()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Count)
+		session.Close()
+	}
+}
+
+func TestJavaScriptIndex(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	javaScriptIndexCanUseMap(t, driver)
+	javaScriptIndexCanUseMapReduce(t, driver)
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+type orderWithCompany struct {
+	OrderID     string
+	CompanyName string
+	Freight     float64
+}
+
+func javaScriptProjectionCanLoadRelatedDocuments(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		company := &Company{
+			ID:   "companies/1",
+			Name: "Hibernating Rhinos",
+		}
+		err = session.Store(company)
+		assert.NoError(t, err)
+		order := &Order{
+			ID:      "orders/1",
+			Company: "companies/1",
+			Freight: 10,
+		}
+		err = session.Store(order)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		projection := ravendb.NewJavaScriptProjection("o").
+			Load("o.company", "c").
+			Field("OrderID", "id(o)").
+			Field("CompanyName", "c.Name").
+			Field("Freight", "o.freight * 2")
+		q := session.QueryCollectionForType(reflect.TypeOf(&Order{}))
+		q = q.SelectJavaScript(reflect.TypeOf(&orderWithCompany{}), projection)
+		q = q.WaitForNonStaleResults(0)
+		var results []*orderWithCompany
+		err = q.GetResults(&results)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(results))
+		result := results[0]
+		assert.Equal(t, "orders/1", result.OrderID)
+		assert.Equal(t, "Hibernating Rhinos", result.CompanyName)
+		assert.Equal(t, 20.0, result.Freight)
+		session.Close()
+	}
+}
+
+func javaScriptProjectionCanUseDeclaredFunction(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("John")
+		user.setLastName("Doe")
+		err = session.Store(user)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		projection := ravendb.NewJavaScriptProjection("u").
+			Declare("fullName", "u", "return u.name + ' ' + u.lastName;").
+			Select("{ FullName: fullName(u) }")
+		type userFullName struct {
+			FullName string
+		}
+		q := ravendb.SelectJavaScript[userFullName](ravendb.Query[User](session), projection)
+		q = q.WaitForNonStaleResults(0)
+		results, err := q.ToList()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, "John Doe", results[0].FullName)
+		session.Close()
+	}
+}
+
+func TestJavaScriptProjection(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	javaScriptProjectionCanLoadRelatedDocuments(t, driver)
+	javaScriptProjectionCanUseDeclaredFunction(t, driver)
+}
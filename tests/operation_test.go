@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func operationCanReportProgress(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		bulkInsert := store.BulkInsert("")
+		for i := 0; i < 1000; i++ {
+			user := &User{}
+			user.setName("John")
+			_, err := bulkInsert.Store(user, nil)
+			assert.NoError(t, err)
+		}
+		err := bulkInsert.Close()
+		assert.NoError(t, err)
+	}
+
+	operation := ravendb.NewPatchByQueryOperation("from Users update { this.name = \"Patched\" }")
+	op, err := store.Operations().SendAsync(operation, nil)
+	assert.NoError(t, err)
+	var lastProgress *ravendb.OperationProgress
+	op.OnProgress = func(progress *ravendb.OperationProgress) {
+		lastProgress = progress
+	}
+	err = op.WaitForCompletionWithTimeout(time.Second * 30)
+	assert.NoError(t, err)
+	// small operations can complete before reporting any progress
+	if lastProgress != nil {
+		assert.True(t, lastProgress.Processed <= lastProgress.Total)
+	}
+}
+
+func operationCanKillOperation(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("John")
+		err := session.Store(user)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	// the script runs long enough to be killed
+	operation := ravendb.NewPatchByQueryOperation("from Users update { var d = new Date().getTime(); while (new Date().getTime() - d < 10000) { } }")
+	op, err := store.Operations().SendAsync(operation, nil)
+	assert.NoError(t, err)
+	err = op.Kill()
+	assert.NoError(t, err)
+
+	err = op.WaitForCompletionWithTimeout(time.Second * 30)
+	_, ok := err.(*ravendb.OperationCancelledError)
+	assert.True(t, ok, "expected OperationCancelledError, got %v", err)
+}
+
+func TestOperation(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	operationCanReportProgress(t, driver)
+	operationCanKillOperation(t, driver)
+}
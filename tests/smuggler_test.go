@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func smugglerStoreDocuments(t *testing.T, store *ravendb.DocumentStore) {
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	for _, name := range []string{"John", "Jane"} {
+		user := &User{}
+		user.setName(name)
+		err := session.Store(user)
+		assert.NoError(t, err)
+	}
+	err := session.Store(&Company{Name: "Acme"})
+	assert.NoError(t, err)
+	err = session.SaveChanges()
+	assert.NoError(t, err)
+}
+
+func smugglerCountOfDocuments(t *testing.T, store *ravendb.DocumentStore) int64 {
+	op := ravendb.NewGetStatisticsOperation("")
+	err := store.Maintenance().Send(op)
+	assert.NoError(t, err)
+	return op.Command.Result.CountOfDocuments
+}
+
+func smugglerCanExportAndImport(t *testing.T, driver *RavenTestDriver) {
+	store1 := driver.getDocumentStoreMust(t)
+	defer store1.Close()
+	store2 := driver.getDocumentStoreMust(t)
+	defer store2.Close()
+
+	smugglerStoreDocuments(t, store1)
+
+	var buf bytes.Buffer
+	op, err := store1.Smuggler().Export(ravendb.NewDatabaseSmugglerExportOptions(), &buf)
+	assert.NoError(t, err)
+	err = op.WaitForCompletion()
+	assert.NoError(t, err)
+	assert.True(t, buf.Len() > 0)
+
+	op, err = store2.Smuggler().Import(ravendb.NewDatabaseSmugglerImportOptions(), &buf)
+	assert.NoError(t, err)
+	err = op.WaitForCompletion()
+	assert.NoError(t, err)
+
+	// 2 users, a company and hilo documents
+	assert.Equal(t, smugglerCountOfDocuments(t, store1), smugglerCountOfDocuments(t, store2))
+
+	session := openSessionMust(t, store2)
+	defer session.Close()
+	var user *User
+	err = session.Load(&user, "users/1-A")
+	assert.NoError(t, err)
+	assert.Equal(t, "John", *user.Name)
+}
+
+func smugglerCanExportToFileWithFilters(t *testing.T, driver *RavenTestDriver) {
+	store1 := driver.getDocumentStoreMust(t)
+	defer store1.Close()
+	store2 := driver.getDocumentStoreMust(t)
+	defer store2.Close()
+
+	smugglerStoreDocuments(t, store1)
+
+	options := ravendb.NewDatabaseSmugglerExportOptions()
+	options.OperateOnTypes = []ravendb.DatabaseItemType{ravendb.DatabaseItemTypeDocuments}
+	options.Collections = []string{"Users"}
+	path := filepath.Join(t.TempDir(), "users.ravendbdump")
+	op, err := store1.Smuggler().ExportToFile(options, path)
+	assert.NoError(t, err)
+	err = op.WaitForCompletion()
+	assert.NoError(t, err)
+
+	op, err = store2.Smuggler().ImportFromFile(ravendb.NewDatabaseSmugglerImportOptions(), path)
+	assert.NoError(t, err)
+	err = op.WaitForCompletion()
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(2), smugglerCountOfDocuments(t, store2))
+}
+
+func smugglerCanExportToDatabase(t *testing.T, driver *RavenTestDriver) {
+	store1 := driver.getDocumentStoreMust(t)
+	defer store1.Close()
+	store2 := driver.getDocumentStoreMust(t)
+	defer store2.Close()
+
+	smugglerStoreDocuments(t, store1)
+
+	op, err := store1.Smuggler().ExportToDatabase(ravendb.NewDatabaseSmugglerExportOptions(), store2.Smuggler())
+	assert.NoError(t, err)
+	err = op.WaitForCompletion()
+	assert.NoError(t, err)
+
+	assert.Equal(t, smugglerCountOfDocuments(t, store1), smugglerCountOfDocuments(t, store2))
+}
+
+func TestSmuggler(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	smugglerCanExportAndImport(t, driver)
+	smugglerCanExportToFileWithFilters(t, driver)
+	smugglerCanExportToDatabase(t, driver)
+}
@@ -0,0 +1,154 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+const sortersMySorterCode = `using System;
+using System.Collections.Generic;
+using Lucene.Net.Index;
+using Lucene.Net.Search;
+using Lucene.Net.Store;
+
+namespace SlowTests.Data.RavenDB_8355
+{
+    public class MySorter : FieldComparator
+    {
+        private readonly string _args;
+
+        public MySorter(string fieldName, int numHits, int sortPos, bool reversed, List<string> diagnostics)
+        {
+            _args = $"{fieldName}:{numHits}:{sortPos}:{reversed}";
+        }
+
+        public override int Compare(int slot1, int slot2)
+        {
+            throw new InvalidOperationException($"Catch me: {_args}");
+        }
+
+        public override void SetBottom(int slot)
+        {
+            throw new InvalidOperationException($"Catch me: {_args}");
+        }
+
+        public override int CompareBottom(int doc, IState state)
+        {
+            throw new InvalidOperationException($"Catch me: {_args}");
+        }
+
+        public override void Copy(int slot, int doc, IState state)
+        {
+            throw new InvalidOperationException($"Catch me: {_args}");
+        }
+
+        public override void SetNextReader(IndexReader reader, int docBase, IState state)
+        {
+            throw new InvalidOperationException($"Catch me: {_args}");
+        }
+
+        public override IComparable this[int slot] => throw new InvalidOperationException($"Catch me: {_args}");
+    }
+}`
+
+func sortersCanQueryWithCustomSorter(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	{
+		session := openSessionMust(t, store)
+		err := session.Store(&Company{Name: "C1"})
+		assert.NoError(t, err)
+		err = session.Store(&Company{Name: "C2"})
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	sorter := &ravendb.SorterDefinition{
+		Name: "MySorter",
+		Code: sortersMySorterCode,
+	}
+	op, err := ravendb.NewPutSortersOperation(sorter)
+	assert.NoError(t, err)
+	err = store.Maintenance().Send(op)
+	assert.NoError(t, err)
+
+	// the same sorter can be sent again
+	sorter.Code = strings.Replace(sortersMySorterCode, "Catch me", "Catch me 2", -1)
+	op, err = ravendb.NewPutSortersOperation(sorter)
+	assert.NoError(t, err)
+	err = store.Maintenance().Send(op)
+	assert.NoError(t, err)
+
+	{
+		session := openSessionMust(t, store)
+
+		var companies []*Company
+		q := session.Advanced().RawQuery("from Companies order by custom(Name, 'MySorter')")
+		err = q.GetResults(&companies)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Catch me 2: Name:2:0:False")
+
+		q2 := session.QueryCollection("Companies").OrderByWithSorter("Name", "MySorter")
+		iq, err := q2.GetIndexQuery()
+		assert.NoError(t, err)
+		assert.Equal(t, "from Companies order by custom(Name, 'MySorter')", iq.GetQuery())
+		err = q2.GetResults(&companies)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Catch me 2: Name:2:0:False")
+
+		q2 = session.QueryCollection("Companies").OrderByDescendingWithSorter("Name", "MySorter")
+		err = q2.GetResults(&companies)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Catch me 2: Name:2:0:True")
+
+		session.Close()
+	}
+
+	deleteOp, err := ravendb.NewDeleteSorterOperation("MySorter")
+	assert.NoError(t, err)
+	err = store.Maintenance().Send(deleteOp)
+	assert.NoError(t, err)
+}
+
+func sortersCustomSortUsing(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	q := session.QueryCollection("Companies").CustomSortUsing("MySorter", false)
+	iq, err := q.GetIndexQuery()
+	assert.NoError(t, err)
+	assert.Equal(t, "from Companies order by custom(id(), 'MySorter')", iq.GetQuery())
+
+	q = session.QueryCollection("Companies").CustomSortUsing("MySorter", true)
+	iq, err = q.GetIndexQuery()
+	assert.NoError(t, err)
+	assert.Equal(t, "from Companies order by custom(id(), 'MySorter') desc", iq.GetQuery())
+
+	q = session.QueryCollection("Companies").CustomSortUsing("", false)
+	assert.Error(t, q.Err())
+	q = session.QueryCollection("Companies").OrderByWithSorter("Name", "")
+	assert.Error(t, q.Err())
+
+	_, err = ravendb.NewPutSortersOperation()
+	assert.Error(t, err)
+	_, err = ravendb.NewDeleteSorterOperation("")
+	assert.Error(t, err)
+}
+
+func TestSorters(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	sortersCanQueryWithCustomSorter(t, driver)
+	sortersCustomSortUsing(t, driver)
+}
@@ -0,0 +1,229 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	ravendb "github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+type HeartRateMeasure struct {
+	Value float64 `ravendb:"tsvalue=0"`
+}
+
+func timeSeriesAppendAndGet(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	baseline := time.Date(2020, 4, 1, 10, 0, 0, 0, time.UTC)
+	{
+		session := openSessionMust(t, store)
+		user := &User{}
+		user.setName("Oren")
+		err = session.StoreWithID(user, "users/ayende")
+		assert.NoError(t, err)
+
+		ts, err := session.TimeSeriesForEntity(user, "Heartrate")
+		assert.NoError(t, err)
+		err = ts.Append(baseline.Add(time.Minute), []float64{59}, "watches/fitbit")
+		assert.NoError(t, err)
+		err = ts.Append(baseline.Add(time.Minute*2), []float64{60}, "watches/fitbit")
+		assert.NoError(t, err)
+		err = ts.AppendTyped(baseline.Add(time.Minute*3), &HeartRateMeasure{Value: 61}, "watches/apple")
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		ts, err := session.TimeSeriesFor("users/ayende", "Heartrate")
+		assert.NoError(t, err)
+		entries, err := ts.Get(nil, nil, 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(entries))
+		assert.Equal(t, 59.0, entries[0].GetValue())
+		assert.Equal(t, "watches/fitbit", entries[0].Tag)
+		assert.True(t, baseline.Add(time.Minute).Equal(entries[0].Timestamp))
+
+		var m HeartRateMeasure
+		err = entries[2].GetTypedValue(&m)
+		assert.NoError(t, err)
+		assert.Equal(t, 61.0, m.Value)
+
+		// a sub-range of already fetched range is served from the session
+		n := session.Advanced().GetNumberOfRequests()
+		from := baseline.Add(time.Minute * 2)
+		entries, err = ts.Get(&from, nil, 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(entries))
+		assert.Equal(t, n, session.Advanced().GetNumberOfRequests())
+		session.Close()
+	}
+
+	{
+		op, err := ravendb.NewGetTimeSeriesOperation("users/ayende", "Heartrate", nil, nil, 1, 1)
+		assert.NoError(t, err)
+		err = store.Operations().Send(op, nil)
+		assert.NoError(t, err)
+		res := op.Command.Result
+		assert.Equal(t, 1, len(res.Entries))
+		assert.Equal(t, 60.0, res.Entries[0].GetValue())
+	}
+}
+
+func timeSeriesDelete(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	baseline := time.Date(2020, 4, 1, 10, 0, 0, 0, time.UTC)
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		ts, err := session.TimeSeriesFor("users/1", "Heartrate")
+		assert.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			err = ts.Append(baseline.Add(time.Duration(i)*time.Second), []float64{float64(i)}, "")
+			assert.NoError(t, err)
+		}
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		ts, err := session.TimeSeriesFor("users/1", "Heartrate")
+		assert.NoError(t, err)
+		from := baseline.Add(time.Second * 2)
+		to := baseline.Add(time.Second * 5)
+		err = ts.Delete(&from, &to)
+		assert.NoError(t, err)
+		err = ts.DeleteAt(baseline)
+		assert.NoError(t, err)
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		op := ravendb.NewTimeSeriesOperation("Heartrate")
+		op.Append(&ravendb.TimeSeriesAppendOperation{
+			Timestamp: baseline.Add(time.Second * 20),
+			Values:    []float64{20},
+		})
+		batchOp, err := ravendb.NewTimeSeriesBatchOperation("users/1", op)
+		assert.NoError(t, err)
+		err = store.Operations().Send(batchOp, nil)
+		assert.NoError(t, err)
+	}
+
+	{
+		session := openSessionMust(t, store)
+		ts, err := session.TimeSeriesFor("users/1", "Heartrate")
+		assert.NoError(t, err)
+		entries, err := ts.Get(nil, nil, 0, 0)
+		assert.NoError(t, err)
+		// 10 appended, 5 deleted, 1 appended through the operation
+		assert.Equal(t, 6, len(entries))
+		session.Close()
+	}
+}
+
+func timeSeriesInclude(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	baseline := time.Date(2020, 4, 1, 10, 0, 0, 0, time.UTC)
+	{
+		session := openSessionMust(t, store)
+		err = session.StoreWithID(&User{}, "users/1")
+		assert.NoError(t, err)
+		ts, err := session.TimeSeriesFor("users/1", "Heartrate")
+		assert.NoError(t, err)
+		for i := 0; i < 5; i++ {
+			err = ts.Append(baseline.Add(time.Duration(i)*time.Minute), []float64{float64(60 + i)}, "")
+			assert.NoError(t, err)
+		}
+		err = session.SaveChanges()
+		assert.NoError(t, err)
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		var user *User
+		err = session.IncludeTimeSeries("Heartrate", nil, nil).Load(&user, "users/1")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, session.Advanced().GetNumberOfRequests())
+
+		ts, err := session.TimeSeriesForEntity(user, "Heartrate")
+		assert.NoError(t, err)
+		entries, err := ts.Get(nil, nil, 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, len(entries))
+		assert.Equal(t, 1, session.Advanced().GetNumberOfRequests())
+		session.Close()
+	}
+
+	{
+		session := openSessionMust(t, store)
+		from := baseline.Add(time.Minute)
+		to := baseline.Add(time.Minute * 3)
+		var users []*User
+		err = session.QueryCollection("users").IncludeTimeSeries("Heartrate", &from, &to).GetResults(&users)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(users))
+
+		ts, err := session.TimeSeriesFor("users/1", "Heartrate")
+		assert.NoError(t, err)
+		entries, err := ts.Get(&from, &to, 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(entries))
+		assert.Equal(t, 1, session.Advanced().GetNumberOfRequests())
+		session.Close()
+	}
+}
+
+func timeSeriesConfigure(t *testing.T, driver *RavenTestDriver) {
+	var err error
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	collectionConfig := &ravendb.TimeSeriesCollectionConfiguration{
+		Policies: []*ravendb.TimeSeriesPolicy{
+			ravendb.NewTimeSeriesPolicy("ByHourFor12Hours", ravendb.TimeValueOfHours(1), ravendb.TimeValueOfHours(48)),
+			ravendb.NewTimeSeriesPolicy("ByDayFor1Year", ravendb.TimeValueOfDays(1), ravendb.TimeValueOfYears(1)),
+		},
+		RawPolicy: ravendb.NewRawTimeSeriesPolicy(ravendb.TimeValueOfHours(96)),
+	}
+	frequency := ravendb.Duration(time.Second)
+	config := &ravendb.TimeSeriesConfiguration{
+		Collections: map[string]*ravendb.TimeSeriesCollectionConfiguration{
+			"Users": collectionConfig,
+		},
+		PolicyCheckFrequency: &frequency,
+	}
+	op, err := ravendb.NewConfigureTimeSeriesOperation(config)
+	assert.NoError(t, err)
+	err = store.Maintenance().Send(op)
+	assert.NoError(t, err)
+	assert.NotNil(t, op.Command.Result.RaftCommandIndex)
+}
+
+func TestTimeSeries(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	timeSeriesAppendAndGet(t, driver)
+	timeSeriesDelete(t, driver)
+	timeSeriesInclude(t, driver)
+	timeSeriesConfigure(t, driver)
+}
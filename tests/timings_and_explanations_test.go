@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ravendb/ravendb-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func timingsAndExplanationsStoreCompanies(t *testing.T, store *ravendb.DocumentStore) {
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	for _, name := range []string{"Micro", "Microsoft", "Google"} {
+		err := session.Store(&Company{Name: name})
+		assert.NoError(t, err)
+	}
+	err := session.SaveChanges()
+	assert.NoError(t, err)
+}
+
+func timingsAndExplanationsCanIncludeTimings(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	timingsAndExplanationsStoreCompanies(t, store)
+
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	var timings *ravendb.QueryTimings
+	var stats *ravendb.QueryStatistics
+	var companies []*Company
+	query := session.QueryCollectionForType(reflect.TypeOf(&Company{})).
+		Timings(&timings).
+		Statistics(&stats).
+		WhereNotEquals("Name", "Google")
+	err := query.GetResults(&companies)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(companies))
+
+	iq, err := query.GetIndexQuery()
+	assert.NoError(t, err)
+	assert.Equal(t, "from Companies where Name != $p0 include timings()", iq.GetQuery())
+
+	assert.True(t, timings.DurationInMs >= 0)
+	assert.NotEmpty(t, timings.Timings)
+	assert.NotEmpty(t, stats.TimingsInMs)
+}
+
+func timingsAndExplanationsCanExplainScores(t *testing.T, driver *RavenTestDriver) {
+	store := driver.getDocumentStoreMust(t)
+	defer store.Close()
+
+	timingsAndExplanationsStoreCompanies(t, store)
+
+	session := openSessionMust(t, store)
+	defer session.Close()
+
+	var explanations *ravendb.Explanations
+	var companies []*Company
+	query := session.QueryCollectionForType(reflect.TypeOf(&Company{})).
+		ExplainScores(&explanations).
+		Search("Name", "Micro*")
+	err := query.GetResults(&companies)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(companies))
+
+	iq, err := query.GetIndexQuery()
+	assert.NoError(t, err)
+	assert.Equal(t, "from Companies where search(Name, $p0) include explanations()", iq.GetQuery())
+
+	for _, company := range companies {
+		companyExplanations := explanations.GetExplanations(company.ID)
+		assert.Equal(t, 1, len(companyExplanations))
+		assert.NotEmpty(t, companyExplanations[0])
+	}
+	assert.Equal(t, 2, len(explanations.GetKeys()))
+
+	// explanations can only be requested once
+	query = session.QueryCollectionForType(reflect.TypeOf(&Company{})).
+		ExplainScores(&explanations).
+		ExplainScores(&explanations)
+	err = query.GetResults(&companies)
+	assert.Error(t, err)
+}
+
+func TestTimingsAndExplanations(t *testing.T) {
+	driver := createTestDriver(t)
+	destroy := func() { destroyDriver(t, driver) }
+	defer recoverTest(t, destroy)
+
+	timingsAndExplanationsCanIncludeTimings(t, driver)
+	timingsAndExplanationsCanExplainScores(t, driver)
+}
@@ -0,0 +1,44 @@
+package ravendb
+
+var _ ICommandData = &TimeSeriesBatchCommandData{}
+
+// TimeSeriesBatchCommandData represents operations on a single time series
+// of a document sent as part of a batch
+type TimeSeriesBatchCommandData struct {
+	*CommandData
+
+	timeSeries *TimeSeriesOperation
+}
+
+// NewTimeSeriesBatchCommandData returns new TimeSeriesBatchCommandData
+func NewTimeSeriesBatchCommandData(documentID string, name string, appends []*TimeSeriesAppendOperation, deletes []*TimeSeriesDeleteOperation) (*TimeSeriesBatchCommandData, error) {
+	if stringIsBlank(documentID) {
+		return nil, newIllegalArgumentError("DocumentId cannot be empty")
+	}
+	if stringIsBlank(name) {
+		return nil, newIllegalArgumentError("Name cannot be empty")
+	}
+
+	res := &TimeSeriesBatchCommandData{
+		CommandData: &CommandData{
+			ID:   documentID,
+			Name: name,
+			Type: CommandTimeSeries,
+		},
+		timeSeries: &TimeSeriesOperation{
+			Name:    name,
+			Appends: appends,
+			Deletes: deletes,
+		},
+	}
+	return res, nil
+}
+
+func (d *TimeSeriesBatchCommandData) serialize(conventions *DocumentConventions) (interface{}, error) {
+	res := map[string]interface{}{
+		"Id":         d.ID,
+		"TimeSeries": d.timeSeries.serialize(),
+		"Type":       d.Type,
+	}
+	return res, nil
+}
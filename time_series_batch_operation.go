@@ -0,0 +1,67 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IOperation = &TimeSeriesBatchOperation{}
+)
+
+// TimeSeriesBatchOperation applies appends and deletes to a time series of a document
+type TimeSeriesBatchOperation struct {
+	Command *TimeSeriesBatchCommand
+
+	documentID string
+	operation  *TimeSeriesOperation
+}
+
+// NewTimeSeriesBatchOperation returns new TimeSeriesBatchOperation
+func NewTimeSeriesBatchOperation(documentID string, operation *TimeSeriesOperation) (*TimeSeriesBatchOperation, error) {
+	if stringIsBlank(documentID) {
+		return nil, newIllegalArgumentError("Document id cannot be empty")
+	}
+	if operation == nil {
+		return nil, newIllegalArgumentError("Operation cannot be null")
+	}
+	return &TimeSeriesBatchOperation{
+		documentID: documentID,
+		operation:  operation,
+	}, nil
+}
+
+func (o *TimeSeriesBatchOperation) GetCommand(store *DocumentStore, conventions *DocumentConventions, cache *httpCache) (RavenCommand, error) {
+	o.Command = newTimeSeriesBatchCommand(o.documentID, o.operation)
+	return o.Command, nil
+}
+
+var _ RavenCommand = &TimeSeriesBatchCommand{}
+
+// TimeSeriesBatchCommand sends TimeSeriesOperation to the server
+type TimeSeriesBatchCommand struct {
+	RavenCommandBase
+
+	documentID string
+	operation  *TimeSeriesOperation
+}
+
+func newTimeSeriesBatchCommand(documentID string, operation *TimeSeriesOperation) *TimeSeriesBatchCommand {
+	cmd := &TimeSeriesBatchCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		documentID: documentID,
+		operation:  operation,
+	}
+	cmd.ResponseType = RavenCommandResponseTypeEmpty
+	return cmd
+}
+
+func (c *TimeSeriesBatchCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/timeseries?docId=" + urlUtilsEscapeDataString(c.documentID)
+
+	d, err := jsonMarshal(c.operation.serialize())
+	if err != nil {
+		return nil, err
+	}
+	return NewHttpPost(url, d)
+}
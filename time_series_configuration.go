@@ -0,0 +1,101 @@
+package ravendb
+
+import (
+	"math"
+)
+
+// TimeValueUnit is a unit of TimeValue
+type TimeValueUnit = string
+
+const (
+	TimeValueUnitNone   = "None"
+	TimeValueUnitSecond = "Second"
+	TimeValueUnitMonth  = "Month"
+)
+
+// TimeValue describes a duration used by time series policies. Months have
+// variable length so they are kept separately from seconds
+type TimeValue struct {
+	Value int           `json:"Value"`
+	Unit  TimeValueUnit `json:"Unit"`
+}
+
+// TimeValueOfSeconds returns TimeValue of a given number of seconds
+func TimeValueOfSeconds(seconds int) TimeValue {
+	return TimeValue{Value: seconds, Unit: TimeValueUnitSecond}
+}
+
+// TimeValueOfMinutes returns TimeValue of a given number of minutes
+func TimeValueOfMinutes(minutes int) TimeValue {
+	return TimeValueOfSeconds(minutes * 60)
+}
+
+// TimeValueOfHours returns TimeValue of a given number of hours
+func TimeValueOfHours(hours int) TimeValue {
+	return TimeValueOfSeconds(hours * 3600)
+}
+
+// TimeValueOfDays returns TimeValue of a given number of days
+func TimeValueOfDays(days int) TimeValue {
+	return TimeValueOfSeconds(days * 24 * 3600)
+}
+
+// TimeValueOfMonths returns TimeValue of a given number of months
+func TimeValueOfMonths(months int) TimeValue {
+	return TimeValue{Value: months, Unit: TimeValueUnitMonth}
+}
+
+// TimeValueOfYears returns TimeValue of a given number of years
+func TimeValueOfYears(years int) TimeValue {
+	return TimeValueOfMonths(years * 12)
+}
+
+// TimeValueMax means "forever" e.g. a retention that never deletes data
+var TimeValueMax = TimeValue{Value: math.MaxInt32, Unit: TimeValueUnitNone}
+
+// TimeSeriesPolicy describes a rollup policy: entries are aggregated over
+// AggregationTime and kept for RetentionTime
+type TimeSeriesPolicy struct {
+	Name            string    `json:"Name"`
+	RetentionTime   TimeValue `json:"RetentionTime"`
+	AggregationTime TimeValue `json:"AggregationTime"`
+}
+
+// NewTimeSeriesPolicy returns new TimeSeriesPolicy. Use TimeValueMax as
+// retentionTime to keep rolled up entries forever
+func NewTimeSeriesPolicy(name string, aggregationTime TimeValue, retentionTime TimeValue) *TimeSeriesPolicy {
+	return &TimeSeriesPolicy{
+		Name:            name,
+		RetentionTime:   retentionTime,
+		AggregationTime: aggregationTime,
+	}
+}
+
+// RawTimeSeriesPolicyName is the name of a policy that applies to raw (not rolled up) entries
+const RawTimeSeriesPolicyName = "rawpolicy"
+
+// NewRawTimeSeriesPolicy returns a policy for raw entries, which are kept for retentionTime
+func NewRawTimeSeriesPolicy(retentionTime TimeValue) *TimeSeriesPolicy {
+	return &TimeSeriesPolicy{
+		Name:            RawTimeSeriesPolicyName,
+		RetentionTime:   retentionTime,
+		AggregationTime: TimeValueMax,
+	}
+}
+
+// TimeSeriesCollectionConfiguration describes time series policies of a collection
+type TimeSeriesCollectionConfiguration struct {
+	Disabled  bool                `json:"Disabled"`
+	Policies  []*TimeSeriesPolicy `json:"Policies"`
+	RawPolicy *TimeSeriesPolicy   `json:"RawPolicy"`
+}
+
+// TimeSeriesConfiguration describes time series configuration of a database
+type TimeSeriesConfiguration struct {
+	// keys are collection names
+	Collections map[string]*TimeSeriesCollectionConfiguration `json:"Collections"`
+	// how often the server checks policies. nil means server's default
+	PolicyCheckFrequency *Duration `json:"PolicyCheckFrequency"`
+	// names of values of time series, keyed by collection and time series name
+	NamedValues map[string]map[string][]string `json:"NamedValues"`
+}
@@ -0,0 +1,125 @@
+package ravendb
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+)
+
+var (
+	// timeSeriesMinTime and timeSeriesMaxTime are used in place of
+	// unbounded ends of a time series range
+	timeSeriesMinTime = time.Time{}
+	timeSeriesMaxTime = time.Date(9999, 12, 31, 23, 59, 59, 999999900, time.UTC)
+)
+
+// TimeSeriesEntry is a single entry of a time series
+type TimeSeriesEntry struct {
+	Timestamp time.Time
+	Tag       string
+	Values    []float64
+	IsRollup  bool
+}
+
+type timeSeriesEntryJSON struct {
+	Timestamp Time      `json:"Timestamp"`
+	Tag       *string   `json:"Tag"`
+	Values    []float64 `json:"Values"`
+	IsRollup  bool      `json:"IsRollup"`
+}
+
+// UnmarshalJSON decodes TimeSeriesEntry as returned by the server
+func (e *TimeSeriesEntry) UnmarshalJSON(d []byte) error {
+	var v timeSeriesEntryJSON
+	if err := json.Unmarshal(d, &v); err != nil {
+		return err
+	}
+	e.Timestamp = time.Time(v.Timestamp)
+	if v.Tag != nil {
+		e.Tag = *v.Tag
+	}
+	e.Values = v.Values
+	e.IsRollup = v.IsRollup
+	return nil
+}
+
+// GetValue returns the first value of the entry
+func (e *TimeSeriesEntry) GetValue() float64 {
+	if len(e.Values) == 0 {
+		return math.NaN()
+	}
+	return e.Values[0]
+}
+
+// GetTypedValue sets fields of result, which must be a pointer to a struct,
+// from values of the entry. See TimeSeriesValuesFromStruct for how values
+// are mapped to fields
+func (e *TimeSeriesEntry) GetTypedValue(result interface{}) error {
+	return timeSeriesValuesToStruct(e.Values, result)
+}
+
+// TimeSeriesRangeResult is a range of time series entries
+type TimeSeriesRangeResult struct {
+	From         time.Time
+	To           time.Time
+	Entries      []*TimeSeriesEntry
+	TotalResults *int64
+}
+
+type timeSeriesRangeResultJSON struct {
+	From         *Time              `json:"From"`
+	To           *Time              `json:"To"`
+	Entries      []*TimeSeriesEntry `json:"Entries"`
+	TotalResults *int64             `json:"TotalResults"`
+}
+
+// UnmarshalJSON decodes TimeSeriesRangeResult as returned by the server
+func (r *TimeSeriesRangeResult) UnmarshalJSON(d []byte) error {
+	var v timeSeriesRangeResultJSON
+	if err := json.Unmarshal(d, &v); err != nil {
+		return err
+	}
+	r.From = timeSeriesMinTime
+	if v.From != nil {
+		r.From = time.Time(*v.From)
+	}
+	r.To = timeSeriesMaxTime
+	if v.To != nil {
+		r.To = time.Time(*v.To)
+	}
+	r.Entries = v.Entries
+	r.TotalResults = v.TotalResults
+	return nil
+}
+
+// covers returns true if the range contains all entries between from and to
+func (r *TimeSeriesRangeResult) covers(from, to time.Time) bool {
+	return !from.Before(r.From) && !to.After(r.To)
+}
+
+// TimeSeriesRange describes a range of a time series to include when loading
+// or querying documents. nil From or To means the range is unbounded
+type TimeSeriesRange struct {
+	Name string
+	From *time.Time
+	To   *time.Time
+}
+
+func timeSeriesFromOrMin(from *time.Time) time.Time {
+	if from == nil {
+		return timeSeriesMinTime
+	}
+	return *from
+}
+
+func timeSeriesToOrMax(to *time.Time) time.Time {
+	if to == nil {
+		return timeSeriesMaxTime
+	}
+	return *to
+}
+
+// formatTimeSeriesTime formats time in the format expected by the server
+func formatTimeSeriesTime(t time.Time) string {
+	return Time(t.UTC()).Format()
+}
@@ -0,0 +1,97 @@
+package ravendb
+
+import (
+	"sort"
+	"time"
+)
+
+// TimeSeriesAppendOperation appends an entry to a time series
+type TimeSeriesAppendOperation struct {
+	Timestamp time.Time
+	Values    []float64
+	Tag       string
+}
+
+func (o *TimeSeriesAppendOperation) serialize() map[string]interface{} {
+	res := map[string]interface{}{
+		"Timestamp": formatTimeSeriesTime(o.Timestamp),
+		"Values":    o.Values,
+	}
+	if o.Tag != "" {
+		res["Tag"] = o.Tag
+	}
+	return res
+}
+
+// TimeSeriesDeleteOperation deletes entries of a time series between From and To
+// (inclusive). nil From or To means the range is unbounded
+type TimeSeriesDeleteOperation struct {
+	From *time.Time
+	To   *time.Time
+}
+
+func (o *TimeSeriesDeleteOperation) serialize() map[string]interface{} {
+	res := map[string]interface{}{
+		"From": nil,
+		"To":   nil,
+	}
+	if o.From != nil {
+		res["From"] = formatTimeSeriesTime(*o.From)
+	}
+	if o.To != nil {
+		res["To"] = formatTimeSeriesTime(*o.To)
+	}
+	return res
+}
+
+// TimeSeriesOperation groups appends and deletes on a single time series
+type TimeSeriesOperation struct {
+	Name string
+	// Appends are sorted by timestamp, with at most one append per timestamp
+	Appends []*TimeSeriesAppendOperation
+	Deletes []*TimeSeriesDeleteOperation
+}
+
+// NewTimeSeriesOperation returns new TimeSeriesOperation for a time series with a given name
+func NewTimeSeriesOperation(name string) *TimeSeriesOperation {
+	return &TimeSeriesOperation{
+		Name: name,
+	}
+}
+
+// Append adds an append operation. It replaces an earlier append
+// with the same timestamp
+func (o *TimeSeriesOperation) Append(appendOperation *TimeSeriesAppendOperation) {
+	n := len(o.Appends)
+	i := sort.Search(n, func(i int) bool {
+		return !o.Appends[i].Timestamp.Before(appendOperation.Timestamp)
+	})
+	if i < n && o.Appends[i].Timestamp.Equal(appendOperation.Timestamp) {
+		o.Appends[i] = appendOperation
+		return
+	}
+	o.Appends = append(o.Appends, nil)
+	copy(o.Appends[i+1:], o.Appends[i:])
+	o.Appends[i] = appendOperation
+}
+
+// Delete adds a delete operation
+func (o *TimeSeriesOperation) Delete(deleteOperation *TimeSeriesDeleteOperation) {
+	o.Deletes = append(o.Deletes, deleteOperation)
+}
+
+func (o *TimeSeriesOperation) serialize() map[string]interface{} {
+	appends := make([]interface{}, len(o.Appends))
+	for i, op := range o.Appends {
+		appends[i] = op.serialize()
+	}
+	deletes := make([]interface{}, len(o.Deletes))
+	for i, op := range o.Deletes {
+		deletes[i] = op.serialize()
+	}
+	return map[string]interface{}{
+		"Name":    o.Name,
+		"Appends": appends,
+		"Deletes": deletes,
+	}
+}
@@ -0,0 +1,127 @@
+package ravendb
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// parseRavenTag parses `ravendb:"opt1,opt2=value"` struct tag into a map
+// of option name to its value (empty for options without a value)
+func parseRavenTag(field reflect.StructField) map[string]string {
+	tag, ok := field.Tag.Lookup("ravendb")
+	if !ok {
+		return nil
+	}
+	res := map[string]string{}
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if idx := strings.IndexByte(part, '='); idx >= 0 {
+			res[strings.TrimSpace(part[:idx])] = strings.TrimSpace(part[idx+1:])
+		} else {
+			res[part] = ""
+		}
+	}
+	return res
+}
+
+// getTimeSeriesValueFields returns indexes of struct fields that hold
+// time series values, in the order of values.
+// Fields are selected with `ravendb:"tsvalue=<n>"` tag where <n> is a position
+// of the value. If no field has the tag, all exported float64 fields are used
+// in the order of declaration.
+func getTimeSeriesValueFields(typ reflect.Type) ([]int, error) {
+	if typ.Kind() != reflect.Struct {
+		return nil, newIllegalArgumentError("type %s is not a struct", typ)
+	}
+	type tsField struct {
+		fieldIdx int
+		position int
+	}
+	var tagged []tsField
+	var untagged []int
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+		if field.Type.Kind() != reflect.Float64 {
+			continue
+		}
+		untagged = append(untagged, i)
+		opts := parseRavenTag(field)
+		posStr, ok := opts["tsvalue"]
+		if !ok {
+			continue
+		}
+		pos, err := strconv.Atoi(posStr)
+		if err != nil || pos < 0 {
+			return nil, newIllegalArgumentError("invalid tsvalue '%s' on field %s.%s", posStr, typ.Name(), field.Name)
+		}
+		tagged = append(tagged, tsField{fieldIdx: i, position: pos})
+	}
+	if len(tagged) == 0 {
+		if len(untagged) == 0 {
+			return nil, newIllegalArgumentError("type %s has no float64 fields", typ)
+		}
+		return untagged, nil
+	}
+
+	sort.Slice(tagged, func(i, j int) bool {
+		return tagged[i].position < tagged[j].position
+	})
+	res := make([]int, len(tagged))
+	for i, f := range tagged {
+		if f.position != i {
+			return nil, newIllegalArgumentError("tsvalue positions of type %s must be unique and start at 0, got %d", typ, f.position)
+		}
+		res[i] = f.fieldIdx
+	}
+	return res, nil
+}
+
+// TimeSeriesValuesFromStruct converts a struct (or a pointer to a struct)
+// to values of a time series entry
+func TimeSeriesValuesFromStruct(v interface{}) ([]float64, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, newIllegalArgumentError("value cannot be nil")
+		}
+		rv = rv.Elem()
+	}
+	fields, err := getTimeSeriesValueFields(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	res := make([]float64, len(fields))
+	for i, fieldIdx := range fields {
+		res[i] = rv.Field(fieldIdx).Float()
+	}
+	return res, nil
+}
+
+func timeSeriesValuesToStruct(values []float64, result interface{}) error {
+	rv := reflect.ValueOf(result)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return newIllegalArgumentError("result must be a non-nil pointer to a struct, is %T", result)
+	}
+	rv = rv.Elem()
+	fields, err := getTimeSeriesValueFields(rv.Type())
+	if err != nil {
+		return err
+	}
+	if len(values) > len(fields) {
+		return fmt.Errorf("time series entry has %d values but type %s has only %d value fields", len(values), rv.Type(), len(fields))
+	}
+	for i, v := range values {
+		rv.Field(fields[i]).SetFloat(v)
+	}
+	return nil
+}
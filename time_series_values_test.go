@@ -0,0 +1,62 @@
+package ravendb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type heartRateMeasure struct {
+	Max  float64 `ravendb:"tsvalue=1"`
+	Min  float64 `ravendb:"tsvalue=0"`
+	Note string
+}
+
+type stockPrice struct {
+	Open  float64
+	Close float64
+	name  float64
+}
+
+func TestTimeSeriesValuesFromStruct(t *testing.T) {
+	values, err := TimeSeriesValuesFromStruct(&heartRateMeasure{Min: 60, Max: 120})
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{60, 120}, values)
+
+	// without tags exported float64 fields are used in order of declaration
+	values, err = TimeSeriesValuesFromStruct(stockPrice{Open: 1.5, Close: 2.5, name: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1.5, 2.5}, values)
+
+	_, err = TimeSeriesValuesFromStruct(struct{ Name string }{})
+	assert.Error(t, err)
+
+	var m heartRateMeasure
+	entry := &TimeSeriesEntry{Values: []float64{55, 130}}
+	err = entry.GetTypedValue(&m)
+	assert.NoError(t, err)
+	assert.Equal(t, 55.0, m.Min)
+	assert.Equal(t, 130.0, m.Max)
+
+	entry = &TimeSeriesEntry{Values: []float64{1, 2, 3}}
+	assert.Error(t, entry.GetTypedValue(&m))
+}
+
+func TestTimeSeriesOperationAppend(t *testing.T) {
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	op := NewTimeSeriesOperation("Heartrate")
+	op.Append(&TimeSeriesAppendOperation{Timestamp: base.Add(time.Minute), Values: []float64{2}})
+	op.Append(&TimeSeriesAppendOperation{Timestamp: base, Values: []float64{1}})
+	op.Append(&TimeSeriesAppendOperation{Timestamp: base.Add(time.Hour), Values: []float64{3}})
+	// the last append for a timestamp wins
+	op.Append(&TimeSeriesAppendOperation{Timestamp: base.Add(time.Minute), Values: []float64{4}, Tag: "watch"})
+
+	var values [][]float64
+	for _, a := range op.Appends {
+		values = append(values, a.Values)
+	}
+	assert.Equal(t, [][]float64{{1}, {4}, {3}}, values)
+	assert.Equal(t, "watch", op.Appends[1].Tag)
+	assert.Equal(t, 3, len(op.serialize()["Appends"].([]interface{})))
+}
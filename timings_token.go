@@ -0,0 +1,3 @@
+package ravendb
+
+var timingsTokenInstance queryToken = singleStringToken("timings()")
@@ -0,0 +1,78 @@
+package ravendb
+
+import (
+	"net/http"
+	"strconv"
+)
+
+var (
+	_ IMaintenanceOperation = &ToggleOngoingTaskStateOperation{}
+)
+
+// ToggleOngoingTaskStateOperation enables or disables an ongoing task
+type ToggleOngoingTaskStateOperation struct {
+	taskID   int64
+	taskType OngoingTaskType
+	disable  bool
+
+	Command *ToggleOngoingTaskStateCommand
+}
+
+// NewToggleOngoingTaskStateOperation returns new ToggleOngoingTaskStateOperation
+func NewToggleOngoingTaskStateOperation(taskID int64, taskType OngoingTaskType, disable bool) *ToggleOngoingTaskStateOperation {
+	return &ToggleOngoingTaskStateOperation{
+		taskID:   taskID,
+		taskType: taskType,
+		disable:  disable,
+	}
+}
+
+// GetCommand returns a command
+func (o *ToggleOngoingTaskStateOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	var err error
+	o.Command, err = NewToggleOngoingTaskStateCommand(o.taskID, o.taskType, o.disable)
+	if err != nil {
+		return nil, err
+	}
+	return o.Command, nil
+}
+
+var _ RavenCommand = &ToggleOngoingTaskStateCommand{}
+
+// ToggleOngoingTaskStateCommand enables or disables an ongoing task
+type ToggleOngoingTaskStateCommand struct {
+	RavenCommandBase
+
+	taskID   int64
+	taskType OngoingTaskType
+	disable  bool
+
+	Result *ModifyOngoingTaskResult
+}
+
+// NewToggleOngoingTaskStateCommand returns new ToggleOngoingTaskStateCommand
+func NewToggleOngoingTaskStateCommand(taskID int64, taskType OngoingTaskType, disable bool) (*ToggleOngoingTaskStateCommand, error) {
+	if taskType == "" {
+		return nil, newIllegalArgumentError("TaskType cannot be empty")
+	}
+	cmd := &ToggleOngoingTaskStateCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		taskID:   taskID,
+		taskType: taskType,
+		disable:  disable,
+	}
+	return cmd, nil
+}
+
+func (c *ToggleOngoingTaskStateCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/tasks/state?key=" + i64toa(c.taskID) + "&type=" + c.taskType + "&disable=" + strconv.FormatBool(c.disable)
+	return NewHttpPost(url, nil)
+}
+
+func (c *ToggleOngoingTaskStateCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
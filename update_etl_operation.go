@@ -0,0 +1,80 @@
+package ravendb
+
+import (
+	"net/http"
+)
+
+var (
+	_ IMaintenanceOperation = &UpdateEtlOperation{}
+)
+
+// UpdateEtlOperation replaces the configuration of an existing ETL task
+type UpdateEtlOperation struct {
+	taskID        int64
+	configuration interface{}
+
+	Command *UpdateEtlCommand
+}
+
+// NewUpdateEtlOperation returns new UpdateEtlOperation.
+// configuration should be *RavenEtlConfiguration or *SqlEtlConfiguration
+func NewUpdateEtlOperation(taskID int64, configuration interface{}) (*UpdateEtlOperation, error) {
+	if err := checkEtlConfiguration(configuration); err != nil {
+		return nil, err
+	}
+	return &UpdateEtlOperation{
+		taskID:        taskID,
+		configuration: configuration,
+	}, nil
+}
+
+// GetCommand returns a command
+func (o *UpdateEtlOperation) GetCommand(conventions *DocumentConventions) (RavenCommand, error) {
+	o.Command = NewUpdateEtlCommand(o.taskID, o.configuration)
+	return o.Command, nil
+}
+
+// UpdateEtlOperationResult is a result of UpdateEtlCommand
+type UpdateEtlOperationResult struct {
+	RaftCommandIndex int64 `json:"RaftCommandIndex"`
+	TaskID           int64 `json:"TaskId"`
+}
+
+var _ RavenCommand = &UpdateEtlCommand{}
+
+// UpdateEtlCommand replaces the configuration of an existing ETL task
+type UpdateEtlCommand struct {
+	RavenCommandBase
+
+	taskID        int64
+	configuration interface{}
+
+	Result *UpdateEtlOperationResult
+}
+
+// NewUpdateEtlCommand returns new UpdateEtlCommand
+func NewUpdateEtlCommand(taskID int64, configuration interface{}) *UpdateEtlCommand {
+	return &UpdateEtlCommand{
+		RavenCommandBase: NewRavenCommandBase(),
+
+		taskID:        taskID,
+		configuration: configuration,
+	}
+}
+
+func (c *UpdateEtlCommand) CreateRequest(node *ServerNode) (*http.Request, error) {
+	url := node.URL + "/databases/" + node.Database + "/admin/etl?id=" + i64toa(c.taskID)
+
+	d, err := jsonMarshal(c.configuration)
+	if err != nil {
+		return nil, err
+	}
+	return newHttpPut(url, d)
+}
+
+func (c *UpdateEtlCommand) SetResponse(response []byte, fromCache bool) error {
+	if len(response) == 0 {
+		return throwInvalidResponse()
+	}
+	return jsonUnmarshal(response, &c.Result)
+}
@@ -0,0 +1,162 @@
+package ravendb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WaitForIndexingOptions are options for MaintenanceOperationExecutor.WaitForIndexingWithOptions
+type WaitForIndexingOptions struct {
+	// Timeout is how long to wait. Default is 1 minute
+	Timeout time.Duration
+	// IndexNames are names of indexes to wait for. If empty, waits for
+	// all indexes that are not disabled
+	IndexNames []string
+	// UseChanges checks indexes after index change notifications instead
+	// of polling them every 100 milliseconds
+	UseChanges bool
+}
+
+const (
+	waitForIndexingPollInterval = time.Millisecond * 100
+	// with changes we still check from time to time, in case a notification
+	// doesn't come e.g. because the connection was lost
+	waitForIndexingPollIntervalWithChanges = time.Second * 5
+)
+
+func waitForIndexing(e *MaintenanceOperationExecutor, options *WaitForIndexingOptions) error {
+	if err := e.assertDatabaseNameSet(); err != nil {
+		return err
+	}
+	timeout := options.Timeout
+	if timeout == 0 {
+		timeout = time.Minute
+	}
+
+	pollInterval := waitForIndexingPollInterval
+	var chChanged chan struct{}
+	if options.UseChanges {
+		chChanged = make(chan struct{}, 1)
+		cb := func(*IndexChange) {
+			select {
+			case chChanged <- struct{}{}:
+			default:
+				// the check wasn't done yet
+			}
+		}
+		cancel, err := e.store.Changes(e.databaseName).ForAllIndexes(cb)
+		if err != nil {
+			return err
+		}
+		defer cancel()
+		pollInterval = waitForIndexingPollIntervalWithChanges
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		op := NewGetStatisticsOperation("")
+		if err := e.Send(op); err != nil {
+			return err
+		}
+		isDone, failed, err := checkIndexesNonStale(op.Command.Result.Indexes, options.IndexNames)
+		if err != nil {
+			return err
+		}
+		if len(failed) > 0 {
+			indexErrors, err := getIndexErrors(e, failed)
+			if err != nil {
+				return err
+			}
+			return newIndexFailedError(indexErrors, "Indexes %s are faulty or in error state.%s", strings.Join(failed, ", "), formatIndexErrors(indexErrors))
+		}
+		if isDone {
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			break
+		}
+		if remaining > pollInterval {
+			remaining = pollInterval
+		}
+		timer := time.NewTimer(remaining)
+		select {
+		case <-chChanged:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+
+	indexErrors, err := getIndexErrors(e, options.IndexNames)
+	if err != nil {
+		return err
+	}
+	return NewTimeoutError("The indexes stayed stale for more than %s.%s", timeout, formatIndexErrors(indexErrors))
+}
+
+// checkIndexesNonStale returns true if indexes with given names (all if
+// indexNames is empty) are non-stale and names of faulty or errored indexes
+func checkIndexesNonStale(indexes []*IndexInformation, indexNames []string) (bool, []string, error) {
+	byName := map[string]*IndexInformation{}
+	for _, index := range indexes {
+		byName[index.Name] = index
+	}
+	var toCheck []*IndexInformation
+	if len(indexNames) == 0 {
+		toCheck = indexes
+	} else {
+		for _, name := range indexNames {
+			index := byName[name]
+			if index == nil {
+				return false, nil, newIndexDoesNotExistError("Index '%s' does not exist", name)
+			}
+			toCheck = append(toCheck, index)
+			if replacement := byName[IndexingSideBySideIndexNamePrefix+name]; replacement != nil {
+				toCheck = append(toCheck, replacement)
+			}
+		}
+	}
+
+	isDone := true
+	var failed []string
+	for _, index := range toCheck {
+		if index.State == IndexStateDisabled {
+			continue
+		}
+		if index.State == IndexStateError || index.Type == IndexTypeFaulty {
+			failed = append(failed, index.Name)
+			continue
+		}
+		if index.IsStale || strings.HasPrefix(index.Name, IndexingSideBySideIndexNamePrefix) {
+			isDone = false
+		}
+	}
+	return isDone, failed, nil
+}
+
+func getIndexErrors(e *MaintenanceOperationExecutor, indexNames []string) ([]*IndexErrors, error) {
+	op := NewGetIndexErrorsOperation(indexNames)
+	if err := e.Send(op); err != nil {
+		return nil, err
+	}
+	return op.Command.Result, nil
+}
+
+func formatIndexErrors(indexErrors []*IndexErrors) string {
+	var lines []string
+	for _, errors := range indexErrors {
+		if len(errors.Errors) == 0 {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("Index %s (%d errors):", errors.Name, len(errors.Errors)))
+		for _, err := range errors.Errors {
+			lines = append(lines, "-"+err.String())
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "\n" + strings.Join(lines, "\n")
+}
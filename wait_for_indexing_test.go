@@ -0,0 +1,177 @@
+package ravendb
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForIndexing(t *testing.T) {
+	var mu sync.Mutex
+	// stats returned by consecutive requests, the last one is repeated
+	var stats []string
+	statsCalls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/databases/db/stats":
+			i := statsCalls
+			if i >= len(stats) {
+				i = len(stats) - 1
+			}
+			statsCalls++
+			_, _ = w.Write([]byte(`{"Indexes":[` + stats[i] + `]}`))
+		case "/databases/db/indexes/errors":
+			_, _ = w.Write([]byte(`{"Results":[{"Name":"Users/ByName","Errors":[{"Error":"Division by zero","Document":"users/1","Action":"Map"}]}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	setStats := func(s ...string) {
+		mu.Lock()
+		stats = s
+		statsCalls = 0
+		mu.Unlock()
+	}
+	getStatsCalls := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return statsCalls
+	}
+
+	store := NewDocumentStore([]string{srv.URL}, "db")
+	store.GetConventions().SetDisableTopologyUpdates(true)
+	require.NoError(t, store.Initialize())
+	defer store.Close()
+	maintenance := store.Maintenance()
+
+	const (
+		byNameStale = `{"Name":"Users/ByName","IsStale":true,"State":"Normal","Type":"Map"}`
+		byName      = `{"Name":"Users/ByName","IsStale":false,"State":"Normal","Type":"Map"}`
+		byNameError = `{"Name":"Users/ByName","IsStale":true,"State":"Error","Type":"Map"}`
+		byAgeStale  = `{"Name":"Users/ByAge","IsStale":true,"State":"Normal","Type":"Map"}`
+		byAgeFaulty = `{"Name":"Users/ByAge","IsStale":true,"State":"Normal","Type":"Faulty"}`
+		disabled    = `{"Name":"Users/Disabled","IsStale":true,"State":"Disabled","Type":"Map"}`
+		replacement = `{"Name":"ReplacementOf/Users/ByName","IsStale":false,"State":"Normal","Type":"Map"}`
+	)
+
+	setStats(byNameStale+","+disabled, byNameStale+","+disabled, byName+","+disabled)
+	err := maintenance.WaitForIndexing("", time.Second*10)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, getStatsCalls())
+
+	// only named indexes, including their side-by-side indexes, are waited for
+	setStats(byName+","+replacement+","+byAgeStale, byName+","+byAgeStale)
+	err = maintenance.WaitForIndexing("db", time.Second*10, "Users/ByName")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, getStatsCalls())
+
+	setStats(byNameStale, byNameError)
+	err = maintenance.WaitForIndexing("", time.Second*10)
+	failedErr, ok := err.(*IndexFailedError)
+	require.True(t, ok, "expected IndexFailedError, got %v", err)
+	require.Equal(t, 1, len(failedErr.IndexErrors))
+	assert.Equal(t, "Division by zero", failedErr.IndexErrors[0].Errors[0].Error)
+	assert.Contains(t, err.Error(), "Users/ByName")
+
+	setStats(byName + "," + byAgeFaulty)
+	err = maintenance.WaitForIndexing("", time.Second*10)
+	_, ok = err.(*IndexFailedError)
+	assert.True(t, ok, "expected IndexFailedError, got %v", err)
+
+	setStats(byAgeStale)
+	err = maintenance.WaitForIndexing("", time.Millisecond*300)
+	_, ok = err.(*TimeoutError)
+	assert.True(t, ok, "expected TimeoutError, got %v", err)
+	assert.Contains(t, err.Error(), "Division by zero")
+
+	setStats(byName)
+	err = maintenance.WaitForIndexing("", time.Second, "Users/Missing")
+	_, ok = err.(*IndexDoesNotExistError)
+	assert.True(t, ok, "expected IndexDoesNotExistError, got %v", err)
+}
+
+func TestWaitForIndexingWithChanges(t *testing.T) {
+	var mu sync.Mutex
+	isStale := true
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/databases/db/stats":
+			mu.Lock()
+			stale := isStale
+			mu.Unlock()
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"Indexes":[{"Name":"Users/ByName","IsStale":%v,"State":"Normal","Type":"Map"}]}`, stale)))
+		case "/databases/db/changes":
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			for {
+				var cmd struct {
+					CommandID int    `json:"CommandId"`
+					Command   string `json:"Command"`
+				}
+				if err := conn.ReadJSON(&cmd); err != nil {
+					return
+				}
+				confirm := []interface{}{
+					map[string]interface{}{"Type": "Confirm", "CommandId": cmd.CommandID},
+				}
+				if err := conn.WriteJSON(confirm); err != nil {
+					return
+				}
+				if cmd.Command != "watch-indexes" {
+					continue
+				}
+				// indexing completes after the client subscribed
+				time.Sleep(200 * time.Millisecond)
+				mu.Lock()
+				isStale = false
+				mu.Unlock()
+				change := []interface{}{
+					map[string]interface{}{
+						"Type": "IndexChange",
+						"Value": map[string]interface{}{
+							"Name": "Users/ByName",
+							"Type": IndexChangeBatchCompleted,
+						},
+					},
+				}
+				if err := conn.WriteJSON(change); err != nil {
+					return
+				}
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	store := NewDocumentStore([]string{srv.URL}, "db")
+	store.GetConventions().SetDisableTopologyUpdates(true)
+	require.NoError(t, store.Initialize())
+	defer store.Close()
+
+	options := &WaitForIndexingOptions{
+		Timeout:    time.Second * 10,
+		IndexNames: []string{"Users/ByName"},
+		UseChanges: true,
+	}
+	start := time.Now()
+	err := store.Maintenance().WaitForIndexingWithOptions("", options)
+	assert.NoError(t, err)
+	// without the notification we'd wait for the next check in 5 seconds
+	assert.True(t, time.Since(start) < waitForIndexingPollIntervalWithChanges, "took %s", time.Since(start))
+}